@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/ship-commander/sc3/internal/audit"
+	"github.com/ship-commander/sc3/internal/beads"
+	"github.com/ship-commander/sc3/internal/protocol"
+	"github.com/spf13/cobra"
+)
+
+var newAuditExporterFn = func(_ context.Context) (auditExporter, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("resolve current directory: %w", err)
+	}
+	client, err := beads.NewClient(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("create beads client: %w", err)
+	}
+	events, err := protocol.NewBeadsStore(client)
+	if err != nil {
+		return nil, fmt.Errorf("open protocol store: %w", err)
+	}
+	store, err := audit.NewBeadsStore()
+	if err != nil {
+		return nil, fmt.Errorf("open audit store: %w", err)
+	}
+	demoTokens, err := audit.NewFileDemoTokenStore(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("open demo token store: %w", err)
+	}
+	return audit.NewExporter(store, store, store, events, demoTokens)
+}
+
+// auditExporter builds the redacted audit archive written by `sc3 audit`.
+type auditExporter interface {
+	ExportAuditTrail(ctx context.Context, commissionID string) ([]byte, error)
+}
+
+func newAuditCommand(logger *log.Logger) *cobra.Command {
+	var outputPath string
+	cmd := &cobra.Command{
+		Use:   "audit <commission-id>",
+		Short: "Export a commission's redacted audit trail as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if logger != nil {
+				logger.With("command", "audit", "commission_id", args[0]).Info("exporting audit trail")
+			}
+			exporter, err := newAuditExporterFn(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(outputPath) == "" {
+				return runAudit(cmd.Context(), exporter, args[0], cmd.OutOrStdout())
+			}
+			// #nosec G304 -- the output path is chosen by the operator running the command.
+			file, err := os.OpenFile(outputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("open audit output: %w", err)
+			}
+			if err := runAudit(cmd.Context(), exporter, args[0], file); err != nil {
+				_ = file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("close audit output: %w", err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the archive to a file instead of stdout")
+	return cmd
+}
+
+func runAudit(ctx context.Context, exporter auditExporter, commissionID string, out io.Writer) error {
+	archive, err := exporter.ExportAuditTrail(ctx, commissionID)
+	if err != nil {
+		return fmt.Errorf("export audit trail: %w", err)
+	}
+	if _, err := out.Write(append(archive, '\n')); err != nil {
+		return fmt.Errorf("write audit archive: %w", err)
+	}
+	return nil
+}
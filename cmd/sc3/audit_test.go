@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRunAuditWritesArchive(t *testing.T) {
+	exporter := fakeAuditExporter{archives: map[string][]byte{"comm-1": []byte(`{"version":"1"}`)}}
+
+	var out bytes.Buffer
+	if err := runAudit(context.Background(), exporter, "comm-1", &out); err != nil {
+		t.Fatalf("run audit: %v", err)
+	}
+	if got := out.String(); got != "{\"version\":\"1\"}\n" {
+		t.Fatalf("audit output = %q", got)
+	}
+	if err := runAudit(context.Background(), exporter, "comm-2", &out); err == nil {
+		t.Fatal("expected error for unknown commission, got nil")
+	}
+}
+
+type fakeAuditExporter struct {
+	archives map[string][]byte
+}
+
+func (f fakeAuditExporter) ExportAuditTrail(_ context.Context, commissionID string) ([]byte, error) {
+	archive, ok := f.archives[commissionID]
+	if !ok {
+		return nil, errors.New("commission not found")
+	}
+	return archive, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/ship-commander/sc3/internal/commander"
+	"github.com/spf13/cobra"
+)
+
+var newWorktreePatherFn = func() (worktreePather, error) {
+	return commander.NewGitWorktreeManager("")
+}
+
+// worktreePather resolves where a mission's worktree is created.
+type worktreePather interface {
+	Path(mission commander.Mission) string
+}
+
+func newExecuteCommand(logger *log.Logger) *cobra.Command {
+	var (
+		explain      bool
+		snapshotPath string
+	)
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Execute approved missions",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !explain {
+				if logger != nil {
+					logger.With("command", cmd.Name()).Info("command scaffold executed")
+				}
+				return nil
+			}
+			if strings.TrimSpace(snapshotPath) == "" {
+				return errors.New("--explain requires --snapshot")
+			}
+			if logger != nil {
+				logger.With("command", "execute", "snapshot", snapshotPath).Info("explaining mission dispatches")
+			}
+			snapshot, err := commander.ReadManifestSnapshot(snapshotPath)
+			if err != nil {
+				return err
+			}
+			worktrees, err := newWorktreePatherFn()
+			if err != nil {
+				return fmt.Errorf("create worktree manager: %w", err)
+			}
+			return runExplain(snapshot, worktrees, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().BoolVar(&explain, "explain", false, "Print each mission's composed dispatch request instead of spawning agents")
+	cmd.Flags().StringVar(&snapshotPath, "snapshot", "", "Manifest snapshot to explain, as written to the commander's snapshot directory")
+	return cmd
+}
+
+// runExplain writes the implementer dispatch request the commander would send for every
+// snapshot mission, in wave order.
+func runExplain(snapshot commander.ManifestSnapshot, worktrees worktreePather, out io.Writer) error {
+	waves, err := commander.ComputeWaves(snapshot.Missions)
+	if err != nil {
+		return fmt.Errorf("compute waves: %w", err)
+	}
+	for i, wave := range waves {
+		for _, mission := range wave {
+			req, err := commander.ComposeDispatchRequest(mission, worktrees.Path(mission))
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(
+				out,
+				"== wave %d mission %s (session %s)\nworktree: %s\n\n%s\n\n",
+				i+1,
+				mission.ID,
+				req.SessionName,
+				req.WorktreePath,
+				strings.TrimSpace(req.Prompt),
+			); err != nil {
+				return fmt.Errorf("write dispatch for %s: %w", mission.ID, err)
+			}
+		}
+	}
+	return nil
+}
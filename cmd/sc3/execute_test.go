@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ship-commander/sc3/internal/commander"
+)
+
+func TestRunExplainPrintsComposedDispatchesInWaveOrder(t *testing.T) {
+	snapshot := commander.ManifestSnapshot{
+		CommissionID: "comm-1",
+		Missions: []commander.Mission{
+			{
+				ID:             "m2",
+				Title:          "API",
+				DependsOn:      []string{"m1"},
+				WaveFeedback:   "keep migrations reversible",
+				ReviewFeedback: "AC-2 lacks a failing test",
+			},
+			{ID: "m1", Title: "Schema"},
+		},
+	}
+	worktrees := fakeWorktreePather{root: "/repo/.beads/worktrees"}
+
+	var out bytes.Buffer
+	if err := runExplain(snapshot, worktrees, &out); err != nil {
+		t.Fatalf("run explain: %v", err)
+	}
+	got := out.String()
+	first := strings.Index(got, "== wave 1 mission m1 (session MISSION-m1)")
+	second := strings.Index(got, "== wave 2 mission m2 (session MISSION-m2)")
+	if first < 0 || second < first {
+		t.Fatalf("explain output does not list m1 then m2 by wave:\n%s", got)
+	}
+	for _, want := range []string{
+		"worktree: " + filepath.Join("/repo/.beads/worktrees", "m1"),
+		"keep migrations reversible",
+		"AC-2 lacks a failing test",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("explain output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestExecuteExplainRequiresSnapshot(t *testing.T) {
+	cmd := newExecuteCommand(nil)
+	cmd.SetArgs([]string{"--explain"})
+	cmd.SetOut(&bytes.Buffer{})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--snapshot") {
+		t.Fatalf("execute --explain error = %v, want missing snapshot error", err)
+	}
+}
+
+type fakeWorktreePather struct {
+	root string
+}
+
+func (f fakeWorktreePather) Path(mission commander.Mission) string {
+	return filepath.Join(f.root, mission.ID)
+}
@@ -69,6 +69,8 @@ var (
 	setTelemetryEndpointOverrideFn     = telemetry.SetEndpointOverride
 	setTelemetryDebugConsoleExporterFn = telemetry.SetDebugConsoleExporter
 	initTelemetryFn                    = telemetry.Init
+	resolveLogsEndpointFn              = telemetry.LogsEndpoint
+	newLogExporterFn                   = telemetry.NewLogExporter
 	setInvariantChecksEnabledFn        = invariants.SetEnabled
 	resolveHarnessAvailabilityFn       = harness.ResolveConfiguredHarness
 	newRootCommandFn                   = newRootCommand
@@ -148,6 +150,20 @@ func run(ctx context.Context, args []string) error {
 		)
 	}
 
+	if logsEndpoint := resolveLogsEndpointFn(); logsEndpoint != "" {
+		logExporter, exporterErr := newLogExporterFn(logsEndpoint)
+		if exporterErr != nil {
+			fmt.Fprintf(os.Stderr, "warning: OTLP log exporter unavailable for %s (%v)\n", logsEndpoint, exporterErr)
+		} else {
+			loggerOptions = append(loggerOptions, logging.WithExportWriter(logExporter))
+			defer func() {
+				if shutdownErr := logExporter.Shutdown(context.Background()); shutdownErr != nil {
+					fmt.Fprintf(os.Stderr, "failed to flush OTLP logs: %v\n", shutdownErr)
+				}
+			}()
+		}
+	}
+
 	logger, err := newRuntimeLoggerFn(spanContext, loggerOptions...)
 	if err != nil {
 		return fmt.Errorf("initialize logging: %w", err)
@@ -218,9 +234,10 @@ func newRootCommand(ctx context.Context, cfg *config.Config, logger *log.Logger)
 	root.AddCommand(
 		newLeafCommand("init", "Initialize Ship Commander 3 project state", logger),
 		newLeafCommand("plan", "Run Ready Room mission planning", logger),
-		newLeafCommand("execute", "Execute approved missions", logger),
+		newExecuteCommand(logger),
 		newLeafCommand("tui", "Launch terminal dashboard", logger),
-		newLeafCommand("status", "Show commission and mission status", logger),
+		newStatusCommand(cfg, logger),
+		newAuditCommand(logger),
 		newBugreportCommand(logger),
 	)
 
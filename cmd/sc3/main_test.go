@@ -58,7 +58,7 @@ func TestRootCommandHelpListsExpectedSubcommands(t *testing.T) {
 	}
 
 	output := stdout.String()
-	expected := []string{"init", "plan", "execute", "tui", "status", "bugreport"}
+	expected := []string{"init", "plan", "execute", "tui", "status", "audit", "bugreport"}
 	for _, name := range expected {
 		if !strings.Contains(output, name) {
 			t.Fatalf("help output missing %q: %s", name, output)
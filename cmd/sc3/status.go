@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/charmbracelet/log"
+	"github.com/ship-commander/sc3/internal/admiral"
+	"github.com/ship-commander/sc3/internal/beads"
+	"github.com/ship-commander/sc3/internal/commander"
+	"github.com/ship-commander/sc3/internal/commission"
+	"github.com/ship-commander/sc3/internal/config"
+	"github.com/ship-commander/sc3/internal/protocol"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// missionLabel marks the Beads issues under a commission that are missions.
+	missionLabel = "type:mission"
+	// commissionIssueType is the Beads issue type commissions are persisted as.
+	commissionIssueType = "feature"
+	closedBeadStatus    = "closed"
+)
+
+var newStatusSourceFn = func(_ context.Context) (statusSource, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("resolve current directory: %w", err)
+	}
+	client, err := beads.NewClient(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("create beads client: %w", err)
+	}
+	store, err := protocol.NewBeadsStore(client)
+	if err != nil {
+		return nil, fmt.Errorf("open protocol store: %w", err)
+	}
+	return &beadsStatusSource{client: client, protocol: store, loadPlan: commission.LoadPlan}, nil
+}
+
+// statusSource reads the Beads and protocol state rendered by `sc3 status`.
+type statusSource interface {
+	Commissions(ctx context.Context) ([]beads.Bead, error)
+	Missions(ctx context.Context, commissionID string) ([]beads.Bead, error)
+	WaveAssignments(ctx context.Context, commissionID string) ([]commission.PlanWave, error)
+	PendingQuestions(ctx context.Context, commissionID string) ([]admiral.AdmiralQuestion, error)
+	ProtocolEvents(ctx context.Context, missionID string) ([]protocol.ProtocolEvent, error)
+}
+
+type statusReport struct {
+	Commissions []commissionStatus `json:"commissions"`
+}
+
+type commissionStatus struct {
+	ID               string          `json:"id"`
+	Title            string          `json:"title"`
+	Status           string          `json:"status"`
+	CurrentWave      int             `json:"currentWave"`
+	TotalWaves       int             `json:"totalWaves"`
+	Missions         []missionStatus `json:"missions"`
+	PendingQuestions []string        `json:"pendingQuestions"`
+}
+
+type missionStatus struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Status       string `json:"status"`
+	Wave         int    `json:"wave"`
+	Revisions    int    `json:"revisions"`
+	MaxRevisions int    `json:"maxRevisions"`
+}
+
+func newStatusCommand(cfg *config.Config, logger *log.Logger) *cobra.Command {
+	var asJSON bool
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show commission and mission status",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if logger != nil {
+				logger.With("command", "status").Info("collecting commission status")
+			}
+			source, err := newStatusSourceFn(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return runStatus(cmd.Context(), source, statusLimitsFromConfig(cfg), cmd.OutOrStdout(), asJSON)
+		},
+	}
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print status as JSON")
+	return cmd
+}
+
+// statusLimits carries the configured execution limits reported next to live state.
+type statusLimits struct {
+	MaxRevisions int
+}
+
+func statusLimitsFromConfig(cfg *config.Config) statusLimits {
+	limits := statusLimits{MaxRevisions: commander.DefaultMaxRevisions}
+	if cfg != nil && cfg.MaxRevisions > 0 {
+		limits.MaxRevisions = cfg.MaxRevisions
+	}
+	return limits
+}
+
+func runStatus(ctx context.Context, source statusSource, limits statusLimits, out io.Writer, asJSON bool) error {
+	report, err := collectStatus(ctx, source, limits)
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("write status JSON: %w", err)
+		}
+		return nil
+	}
+	return renderStatus(out, report)
+}
+
+func collectStatus(ctx context.Context, source statusSource, limits statusLimits) (statusReport, error) {
+	commissions, err := source.Commissions(ctx)
+	if err != nil {
+		return statusReport{}, fmt.Errorf("list commissions: %w", err)
+	}
+
+	report := statusReport{Commissions: make([]commissionStatus, 0, len(commissions))}
+	for _, bead := range commissions {
+		if !isCommissionBead(bead) {
+			continue
+		}
+		status, err := collectCommissionStatus(ctx, source, limits, bead)
+		if err != nil {
+			return statusReport{}, err
+		}
+		report.Commissions = append(report.Commissions, status)
+	}
+	sort.Slice(report.Commissions, func(i, j int) bool {
+		return report.Commissions[i].ID < report.Commissions[j].ID
+	})
+	return report, nil
+}
+
+// isCommissionBead reports whether a feature bead holds an sc3 commission, which
+// commission.Persist stores as JSON with a lifecycle status in the description. Other
+// feature issues in the same Beads database are not commissions.
+func isCommissionBead(bead beads.Bead) bool {
+	var persisted commission.Commission
+	if err := json.Unmarshal([]byte(strings.TrimSpace(bead.Description)), &persisted); err != nil {
+		return false
+	}
+	switch persisted.Status {
+	case commission.StatusPlanning, commission.StatusApproved, commission.StatusExecuting,
+		commission.StatusCompleted, commission.StatusShelved:
+		return true
+	default:
+		return false
+	}
+}
+
+func collectCommissionStatus(
+	ctx context.Context,
+	source statusSource,
+	limits statusLimits,
+	bead beads.Bead,
+) (commissionStatus, error) {
+	status := commissionStatus{
+		ID:               bead.ID,
+		Title:            bead.Title,
+		Status:           bead.Status,
+		Missions:         []missionStatus{},
+		PendingQuestions: []string{},
+	}
+
+	waves, err := source.WaveAssignments(ctx, bead.ID)
+	if err != nil {
+		return commissionStatus{}, fmt.Errorf("load wave assignments for %s: %w", bead.ID, err)
+	}
+	waveByMission := make(map[string]int)
+	for _, wave := range waves {
+		for _, missionID := range wave.MissionIDs {
+			waveByMission[missionID] = wave.Index
+		}
+		status.TotalWaves = max(status.TotalWaves, wave.Index)
+	}
+
+	missions, err := source.Missions(ctx, bead.ID)
+	if err != nil {
+		return commissionStatus{}, fmt.Errorf("list missions for %s: %w", bead.ID, err)
+	}
+	for _, mission := range missions {
+		events, err := source.ProtocolEvents(ctx, mission.ID)
+		if err != nil {
+			return commissionStatus{}, fmt.Errorf("read protocol events for %s: %w", mission.ID, err)
+		}
+		wave := waveByMission[mission.ID]
+		status.Missions = append(status.Missions, missionStatus{
+			ID:           mission.ID,
+			Title:        mission.Title,
+			Status:       mission.Status,
+			Wave:         wave,
+			Revisions:    countRevisions(events),
+			MaxRevisions: limits.MaxRevisions,
+		})
+		if mission.Status != closedBeadStatus && wave > 0 && (status.CurrentWave == 0 || wave < status.CurrentWave) {
+			status.CurrentWave = wave
+		}
+	}
+	if status.CurrentWave == 0 {
+		status.CurrentWave = status.TotalWaves
+	}
+	sort.Slice(status.Missions, func(i, j int) bool {
+		if status.Missions[i].Wave != status.Missions[j].Wave {
+			return status.Missions[i].Wave < status.Missions[j].Wave
+		}
+		return status.Missions[i].ID < status.Missions[j].ID
+	})
+
+	questions, err := source.PendingQuestions(ctx, bead.ID)
+	if err != nil {
+		return commissionStatus{}, fmt.Errorf("list pending questions for %s: %w", bead.ID, err)
+	}
+	for _, question := range questions {
+		status.PendingQuestions = append(status.PendingQuestions, strings.TrimSpace(question.QuestionText))
+	}
+	return status, nil
+}
+
+// countRevisions counts NEEDS_FIXES review verdicts, each of which sent the mission back
+// for another revision.
+func countRevisions(events []protocol.ProtocolEvent) int {
+	revisions := 0
+	for _, event := range events {
+		if verdict, ok := protocol.ReviewVerdict(event); ok && verdict == protocol.ReviewVerdictNeedsFixes {
+			revisions++
+		}
+	}
+	return revisions
+}
+
+func renderStatus(out io.Writer, report statusReport) error {
+	if len(report.Commissions) == 0 {
+		_, err := fmt.Fprintln(out, "No commissions found.")
+		return err
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for i, status := range report.Commissions {
+		if i > 0 {
+			fmt.Fprintln(writer)
+		}
+		fmt.Fprintf(writer, "Commission %s %q [%s] wave %d/%d\n", status.ID, status.Title, status.Status, status.CurrentWave, status.TotalWaves)
+		fmt.Fprintln(writer, "  MISSION\tSTATUS\tWAVE\tREVISIONS")
+		for _, mission := range status.Missions {
+			fmt.Fprintf(writer, "  %s\t%s\t%d\t%d/%d\n", mission.ID, mission.Status, mission.Wave, mission.Revisions, mission.MaxRevisions)
+		}
+		fmt.Fprintf(writer, "  Pending Admiral questions: %d\n", len(status.PendingQuestions))
+		for _, question := range status.PendingQuestions {
+			fmt.Fprintf(writer, "    - %s\n", question)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("write status: %w", err)
+	}
+	return nil
+}
+
+// beadsStatusSource reads commissions, missions, and pending Admiral questions from Beads and
+// review history from the Beads-backed protocol store.
+type beadsStatusSource struct {
+	client   *beads.Client
+	protocol protocol.EventStore
+	loadPlan func(ctx context.Context, commissionID string) (commission.PlanState, error)
+}
+
+func (s *beadsStatusSource) Commissions(_ context.Context) ([]beads.Bead, error) {
+	return s.client.List(beads.ListOpts{Type: commissionIssueType})
+}
+
+func (s *beadsStatusSource) Missions(_ context.Context, commissionID string) ([]beads.Bead, error) {
+	return s.client.List(beads.ListOpts{Parent: commissionID, Labels: []string{missionLabel}})
+}
+
+func (s *beadsStatusSource) WaveAssignments(ctx context.Context, commissionID string) ([]commission.PlanWave, error) {
+	plan, err := s.loadPlan(ctx, commissionID)
+	if errors.Is(err, commission.ErrNoPersistedPlan) {
+		// Commissions still being planned have no wave assignments yet.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan.WaveAssignments, nil
+}
+
+// PendingQuestions reads the question comments admiral.BeadsQuestionRecorder writes on the
+// commission bead.
+func (s *beadsStatusSource) PendingQuestions(_ context.Context, commissionID string) ([]admiral.AdmiralQuestion, error) {
+	bead, err := s.client.Show(commissionID)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]string, 0, len(bead.Comments))
+	for _, comment := range bead.Comments {
+		comments = append(comments, comment.Text)
+	}
+	return admiral.PendingQuestionsFromComments(comments)
+}
+
+func (s *beadsStatusSource) ProtocolEvents(ctx context.Context, missionID string) ([]protocol.ProtocolEvent, error) {
+	return s.protocol.ListByMission(ctx, missionID)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ship-commander/sc3/internal/admiral"
+	"github.com/ship-commander/sc3/internal/beads"
+	"github.com/ship-commander/sc3/internal/commander"
+	"github.com/ship-commander/sc3/internal/commission"
+	"github.com/ship-commander/sc3/internal/config"
+	"github.com/ship-commander/sc3/internal/protocol"
+)
+
+func TestRunStatusRendersWaveRevisionAndQuestionState(t *testing.T) {
+	source := newFakeStatusSource()
+
+	var out bytes.Buffer
+	if err := runStatus(context.Background(), source, statusLimitsFromConfig(nil), &out, false); err != nil {
+		t.Fatalf("run status: %v", err)
+	}
+
+	output := out.String()
+	for _, want := range []string{
+		`Commission comm-1 "Fleet upgrade" [open] wave 2/3`,
+		"MISSION",
+		"REVISIONS",
+		"Pending Admiral questions: 1",
+		"- Which database should missions target?",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("status output missing %q:\n%s", want, output)
+		}
+	}
+	if !containsFields(output, "m-2", "in_progress", "2", "2/3") {
+		t.Fatalf("status output missing m-2 wave and revision row:\n%s", output)
+	}
+	if !containsFields(output, "m-1", "closed", "1", "0/3") {
+		t.Fatalf("status output missing m-1 wave and revision row:\n%s", output)
+	}
+}
+
+func TestRunStatusJSONIncludesWaveAndRevisionFields(t *testing.T) {
+	source := newFakeStatusSource()
+
+	var out bytes.Buffer
+	if err := runStatus(context.Background(), source, statusLimits{MaxRevisions: 5}, &out, true); err != nil {
+		t.Fatalf("run status: %v", err)
+	}
+
+	var report statusReport
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatalf("decode status JSON: %v\n%s", err, out.String())
+	}
+	if len(report.Commissions) != 1 {
+		t.Fatalf("commissions = %d, want 1", len(report.Commissions))
+	}
+	status := report.Commissions[0]
+	if status.CurrentWave != 2 || status.TotalWaves != 3 {
+		t.Fatalf("wave = %d/%d, want 2/3", status.CurrentWave, status.TotalWaves)
+	}
+	want := []missionStatus{
+		{ID: "m-1", Title: "Schema", Status: "closed", Wave: 1, Revisions: 0, MaxRevisions: 5},
+		{ID: "m-2", Title: "API", Status: "in_progress", Wave: 2, Revisions: 2, MaxRevisions: 5},
+		{ID: "m-3", Title: "UI", Status: "open", Wave: 3, Revisions: 0, MaxRevisions: 5},
+	}
+	if len(status.Missions) != len(want) {
+		t.Fatalf("missions = %+v, want %+v", status.Missions, want)
+	}
+	for i := range want {
+		if status.Missions[i] != want[i] {
+			t.Fatalf("mission %d = %+v, want %+v", i, status.Missions[i], want[i])
+		}
+	}
+}
+
+func TestStatusLimitsFromConfigUsesConfiguredMaxRevisions(t *testing.T) {
+	cfg := &config.Config{MaxRevisions: 7}
+	if got := statusLimitsFromConfig(cfg).MaxRevisions; got != 7 {
+		t.Fatalf("max revisions = %d, want configured 7", got)
+	}
+	if got := statusLimitsFromConfig(nil).MaxRevisions; got != commander.DefaultMaxRevisions {
+		t.Fatalf("max revisions without config = %d, want %d", got, commander.DefaultMaxRevisions)
+	}
+}
+
+func TestRunStatusSkipsNonCommissionFeaturesAndReportsUnplannedCommission(t *testing.T) {
+	source := newFakeStatusSource()
+	source.commissions = []beads.Bead{
+		{ID: "comm-2", Title: "Draft", Status: "open", Description: `{"status":"planning"}`},
+		{ID: "feat-9", Title: "Unrelated feature", Status: "open", Description: "Ship a dark mode toggle."},
+	}
+	plans := &beadsStatusSource{loadPlan: func(_ context.Context, commissionID string) (commission.PlanState, error) {
+		return commission.PlanState{}, fmt.Errorf("commission %s has %w", commissionID, commission.ErrNoPersistedPlan)
+	}}
+	source.loadWaves = plans.WaveAssignments
+
+	var out bytes.Buffer
+	if err := runStatus(context.Background(), source, statusLimits{MaxRevisions: 3}, &out, true); err != nil {
+		t.Fatalf("run status: %v", err)
+	}
+	var report statusReport
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatalf("decode status JSON: %v\n%s", err, out.String())
+	}
+	if len(report.Commissions) != 1 || report.Commissions[0].ID != "comm-2" {
+		t.Fatalf("commissions = %+v, want only comm-2", report.Commissions)
+	}
+	if got := report.Commissions[0]; got.CurrentWave != 0 || got.TotalWaves != 0 {
+		t.Fatalf("unplanned commission wave = %d/%d, want 0/0", got.CurrentWave, got.TotalWaves)
+	}
+}
+
+func containsFields(output string, fields ...string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Join(strings.Fields(line), " ") == strings.Join(fields, " ") {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeStatusSource struct {
+	commissions []beads.Bead
+	missions    map[string][]beads.Bead
+	waves       map[string][]commission.PlanWave
+	questions   map[string][]admiral.AdmiralQuestion
+	events      map[string][]protocol.ProtocolEvent
+	// loadWaves replaces the waves map when set.
+	loadWaves func(ctx context.Context, commissionID string) ([]commission.PlanWave, error)
+}
+
+func newFakeStatusSource() *fakeStatusSource {
+	verdict := func(missionID, value string) protocol.ProtocolEvent {
+		return protocol.ProtocolEvent{
+			ProtocolVersion: protocol.ProtocolVersion,
+			Type:            protocol.EventTypeReviewComplete,
+			MissionID:       missionID,
+			Payload:         json.RawMessage(`{"verdict":"` + value + `"}`),
+		}
+	}
+	return &fakeStatusSource{
+		commissions: []beads.Bead{{ID: "comm-1", Title: "Fleet upgrade", Status: "open", Description: `{"status":"executing"}`}},
+		missions: map[string][]beads.Bead{
+			"comm-1": {
+				{ID: "m-3", Title: "UI", Status: "open"},
+				{ID: "m-1", Title: "Schema", Status: "closed"},
+				{ID: "m-2", Title: "API", Status: "in_progress"},
+			},
+		},
+		waves: map[string][]commission.PlanWave{
+			"comm-1": {
+				{Index: 1, MissionIDs: []string{"m-1"}},
+				{Index: 2, MissionIDs: []string{"m-2"}},
+				{Index: 3, MissionIDs: []string{"m-3"}},
+			},
+		},
+		questions: map[string][]admiral.AdmiralQuestion{
+			"comm-1": {{QuestionID: "q-1", AskingAgent: "captain", QuestionText: "Which database should missions target?"}},
+		},
+		events: map[string][]protocol.ProtocolEvent{
+			"m-1": {verdict("m-1", protocol.ReviewVerdictApproved)},
+			"m-2": {
+				verdict("m-2", protocol.ReviewVerdictNeedsFixes),
+				{ProtocolVersion: protocol.ProtocolVersion, Type: protocol.EventTypeAgentClaim, MissionID: "m-2"},
+				verdict("m-2", protocol.ReviewVerdictNeedsFixes),
+			},
+		},
+	}
+}
+
+func (f *fakeStatusSource) Commissions(context.Context) ([]beads.Bead, error) {
+	return f.commissions, nil
+}
+
+func (f *fakeStatusSource) Missions(_ context.Context, commissionID string) ([]beads.Bead, error) {
+	return f.missions[commissionID], nil
+}
+
+func (f *fakeStatusSource) WaveAssignments(ctx context.Context, commissionID string) ([]commission.PlanWave, error) {
+	if f.loadWaves != nil {
+		return f.loadWaves(ctx, commissionID)
+	}
+	return f.waves[commissionID], nil
+}
+
+func (f *fakeStatusSource) PendingQuestions(_ context.Context, commissionID string) ([]admiral.AdmiralQuestion, error) {
+	return f.questions[commissionID], nil
+}
+
+func (f *fakeStatusSource) ProtocolEvents(_ context.Context, missionID string) ([]protocol.ProtocolEvent, error) {
+	return f.events[missionID], nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +12,17 @@ import (
 
 const defaultApprovalGateBuffer = 1
 
+// ApprovalGateBufferForWaves returns the documented default buffer size for a plan
+// with the given number of waves: one slot for manifest approval plus one slot per
+// wave review, so deep plans never block on a too-small buffer. The buffer is fixed once
+// the gate is built, so size it with NewApprovalGate(ApprovalGateBufferForWaves(n)).
+func ApprovalGateBufferForWaves(waveCount int) int {
+	if waveCount < 0 {
+		waveCount = 0
+	}
+	return defaultApprovalGateBuffer + waveCount
+}
+
 // CoverageStatus captures use-case coverage status presented during manifest approval.
 type CoverageStatus string
 
@@ -36,6 +48,9 @@ type Mission struct {
 	ClassificationCriteria    []string
 	ClassificationConfidence  string
 	ClassificationNeedsReview bool
+	// ClassificationReviewSource records how the Admiral resolved a low-confidence
+	// classification ("admiral_confirmed" or "admiral_reclassified"); empty when unreviewed.
+	ClassificationReviewSource string
 }
 
 // Wave is one deterministic execution wave assignment for approval review.
@@ -66,6 +81,24 @@ const (
 type WaveReview struct {
 	WaveIndex  int
 	DemoTokens map[string]string
+	// DemoTokenOrder lists DemoTokens mission IDs sorted, for stable presentation.
+	DemoTokenOrder []string
+	// HaltedMissions lists wave missions that halted while the rest of the wave continued.
+	HaltedMissions []string
+}
+
+// ReviewEscalation carries a reviewer ESCALATE verdict that needs an Admiral decision.
+// Approved completes the mission, Feedback sends it back for revision with the feedback,
+// and Halted or Shelved halt it.
+//
+//nolint:revive // Field names follow the issue contract.
+type ReviewEscalation struct {
+	MissionID         string
+	WaveIndex         int
+	RevisionCount     int
+	ReviewerSessionID string
+	// Reason is the reviewer's explanation of why the case needs human judgment.
+	Reason string
 }
 
 // ApprovalRequest is the manifest approval payload presented to Admiral.
@@ -79,6 +112,7 @@ type ApprovalRequest struct {
 	Iteration       int
 	MaxIterations   int
 	WaveReview      *WaveReview
+	Escalation      *ReviewEscalation
 }
 
 // ApprovalResponse is the Admiral decision payload for manifest review.
@@ -87,6 +121,8 @@ type ApprovalRequest struct {
 type ApprovalResponse struct {
 	Decision     ApprovalDecision
 	FeedbackText string
+	// Actor identifies who made the decision; empty means the Admiral.
+	Actor string
 }
 
 // ApprovalRecord captures one approval request/response interaction.
@@ -103,8 +139,14 @@ type ApprovalGate struct {
 	responses chan ApprovalResponse
 	now       func() time.Time
 
-	mu      sync.Mutex
-	history []ApprovalRecord
+	mu       sync.Mutex
+	history  []ApprovalRecord
+	recorder ApprovalRecorder
+}
+
+// ApprovalRecorder durably persists answered approval requests.
+type ApprovalRecorder interface {
+	RecordApproval(ctx context.Context, record ApprovalRecord) error
 }
 
 // NewApprovalGate constructs a blocking approval gate.
@@ -120,7 +162,15 @@ func NewApprovalGate(bufferSize int) *ApprovalGate {
 	}
 }
 
+// SetRecorder persists every subsequent decision through recorder; nil disables persistence.
+func (g *ApprovalGate) SetRecorder(recorder ApprovalRecorder) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.recorder = recorder
+}
+
 // Requests exposes approval requests to subscribers (for example, TUI approval modal handling).
+// The channel is fixed for the gate's lifetime, so subscribers may hold on to it.
 func (g *ApprovalGate) Requests() <-chan ApprovalRequest {
 	return g.requests
 }
@@ -167,7 +217,13 @@ func (g *ApprovalGate) AwaitDecision(ctx context.Context, request ApprovalReques
 		}
 		g.mu.Lock()
 		g.history = append(g.history, record)
+		recorder := g.recorder
 		g.mu.Unlock()
+		if recorder != nil {
+			if err := recorder.RecordApproval(ctx, record); err != nil {
+				return response, fmt.Errorf("record approval decision: %w", err)
+			}
+		}
 		return response, nil
 	case <-ctx.Done():
 		return ApprovalResponse{}, ctx.Err()
@@ -211,6 +267,7 @@ func normalizeApprovalRequest(request ApprovalRequest) (ApprovalRequest, error)
 	}
 	request.CoverageMap = coverage
 	request.WaveReview = normalizeWaveReview(request.WaveReview)
+	request.Escalation = normalizeEscalation(request.Escalation)
 
 	if request.Iteration <= 0 {
 		request.Iteration = 1
@@ -256,6 +313,23 @@ func normalizeWaves(waves []Wave) []Wave {
 	return normalized
 }
 
+func normalizeEscalation(escalation *ReviewEscalation) *ReviewEscalation {
+	if escalation == nil {
+		return nil
+	}
+	missionID := strings.TrimSpace(escalation.MissionID)
+	if missionID == "" {
+		return nil
+	}
+	return &ReviewEscalation{
+		MissionID:         missionID,
+		WaveIndex:         escalation.WaveIndex,
+		RevisionCount:     escalation.RevisionCount,
+		ReviewerSessionID: strings.TrimSpace(escalation.ReviewerSessionID),
+		Reason:            strings.TrimSpace(escalation.Reason),
+	}
+}
+
 func normalizeWaveReview(review *WaveReview) *WaveReview {
 	if review == nil {
 		return nil
@@ -279,9 +353,20 @@ func normalizeWaveReview(review *WaveReview) *WaveReview {
 	}
 
 	return &WaveReview{
-		WaveIndex:  review.WaveIndex,
-		DemoTokens: demoTokens,
+		WaveIndex:      review.WaveIndex,
+		DemoTokens:     demoTokens,
+		DemoTokenOrder: SortedDemoTokenIDs(demoTokens),
+	}
+}
+
+// SortedDemoTokenIDs returns the mission IDs of demoTokens in ascending order.
+func SortedDemoTokenIDs(demoTokens map[string]string) []string {
+	ids := make([]string, 0, len(demoTokens))
+	for missionID := range demoTokens {
+		ids = append(ids, missionID)
 	}
+	sort.Strings(ids)
+	return ids
 }
 
 func normalizeStringSlice(values []string) []string {
@@ -311,6 +396,7 @@ func normalizeCoverageStatus(status CoverageStatus) CoverageStatus {
 
 func normalizeApprovalResponse(response ApprovalResponse) (ApprovalResponse, error) {
 	response.FeedbackText = strings.TrimSpace(response.FeedbackText)
+	response.Actor = strings.TrimSpace(response.Actor)
 
 	switch strings.ToLower(strings.TrimSpace(string(response.Decision))) {
 	case strings.ToLower(string(ApprovalDecisionApproved)):
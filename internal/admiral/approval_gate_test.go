@@ -113,3 +113,46 @@ func TestApprovalGateRejectsInvalidRequest(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestApprovalGateSizedForWavesBuffersEveryDecision(t *testing.T) {
+	t.Parallel()
+
+	const pending = 3
+	gate := NewApprovalGate(ApprovalGateBufferForWaves(pending - 1))
+
+	// Manifest approval plus both wave reviews fit before any request is read.
+	for i := 0; i < pending; i++ {
+		done := make(chan error, 1)
+		go func() { done <- gate.Respond(ApprovalResponse{Decision: ApprovalDecisionApproved}) }()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("respond: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("respond %d blocked on a full buffer", i+1)
+		}
+	}
+
+	request := ApprovalRequest{
+		CommissionID:    "commission-1",
+		MissionManifest: []Mission{{ID: "M-1", Title: "Bootstrap runtime", UseCaseIDs: []string{"UC-1"}}},
+	}
+	go func() {
+		for i := 0; i < pending; i++ {
+			<-gate.Requests()
+		}
+	}()
+	for i := 0; i < pending; i++ {
+		response, err := gate.AwaitDecision(context.Background(), request)
+		if err != nil {
+			t.Fatalf("await decision: %v", err)
+		}
+		if response.Decision != ApprovalDecisionApproved {
+			t.Fatalf("decision = %q, want approved", response.Decision)
+		}
+	}
+	if got := len(gate.History()); got != pending {
+		t.Fatalf("history entries = %d, want %d", got, pending)
+	}
+}
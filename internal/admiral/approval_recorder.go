@@ -0,0 +1,74 @@
+package admiral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultApprovalActor = "admiral"
+
+type beadsApprovalWriter interface {
+	SetState(id, key, value string) error
+	AddComment(id, comment string) error
+}
+
+// BeadsApprovalRecorder persists approval decisions on the commission bead so they
+// survive restarts: state keys hold the latest decision and a comment keeps the audit trail.
+type BeadsApprovalRecorder struct {
+	beads beadsApprovalWriter
+}
+
+// NewBeadsApprovalRecorder creates an ApprovalRecorder backed by Beads.
+func NewBeadsApprovalRecorder(beads beadsApprovalWriter) (*BeadsApprovalRecorder, error) {
+	if beads == nil {
+		return nil, errors.New("beads client is required")
+	}
+	return &BeadsApprovalRecorder{beads: beads}, nil
+}
+
+// RecordApproval writes the decision, actor, and timestamp onto the commission bead.
+func (r *BeadsApprovalRecorder) RecordApproval(_ context.Context, record ApprovalRecord) error {
+	commissionID := strings.TrimSpace(record.Request.CommissionID)
+	if commissionID == "" {
+		return errors.New("commission id must not be empty")
+	}
+	actor := strings.TrimSpace(record.Response.Actor)
+	if actor == "" {
+		actor = defaultApprovalActor
+	}
+	decidedAt := record.AnsweredAt.UTC().Format(time.RFC3339Nano)
+
+	base := "approval.manifest"
+	subject := "manifest approval"
+	if record.Request.WaveReview != nil {
+		base = fmt.Sprintf("approval.wave_%d", record.Request.WaveReview.WaveIndex)
+		subject = fmt.Sprintf("wave %d review", record.Request.WaveReview.WaveIndex)
+	}
+	if record.Request.Escalation != nil {
+		base = fmt.Sprintf("approval.escalation_%s", record.Request.Escalation.MissionID)
+		subject = fmt.Sprintf("mission %s escalation", record.Request.Escalation.MissionID)
+	}
+
+	updates := []struct{ key, value string }{
+		{base + ".decision", string(record.Response.Decision)},
+		{base + ".actor", actor},
+		{base + ".decided_at", decidedAt},
+	}
+	for _, update := range updates {
+		if err := r.beads.SetState(commissionID, update.key, update.value); err != nil {
+			return fmt.Errorf("set state %q: %w", update.key, err)
+		}
+	}
+
+	comment := fmt.Sprintf("%s: %s by %s at %s", subject, record.Response.Decision, actor, decidedAt)
+	if feedback := strings.TrimSpace(record.Response.FeedbackText); feedback != "" {
+		comment += " (feedback: " + feedback + ")"
+	}
+	if err := r.beads.AddComment(commissionID, comment); err != nil {
+		return fmt.Errorf("add approval comment: %w", err)
+	}
+	return nil
+}
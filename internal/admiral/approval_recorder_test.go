@@ -0,0 +1,104 @@
+package admiral
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApprovalGatePersistsDecisionToBeads(t *testing.T) {
+	t.Parallel()
+
+	beads := &fakeApprovalBeads{states: make(map[string]string)}
+	recorder, err := NewBeadsApprovalRecorder(beads)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	decidedAt := time.Date(2026, 2, 10, 9, 30, 0, 0, time.UTC)
+	gate := NewApprovalGate(1)
+	gate.now = func() time.Time { return decidedAt }
+	gate.SetRecorder(recorder)
+
+	go func() {
+		<-gate.Requests()
+		_ = gate.Respond(ApprovalResponse{Decision: ApprovalDecisionApproved, Actor: "captain-jc"})
+	}()
+
+	response, err := gate.AwaitDecision(context.Background(), ApprovalRequest{
+		CommissionID:    "commission-1",
+		MissionManifest: []Mission{{ID: "M-1", Title: "Bootstrap runtime"}},
+		Iteration:       1,
+		MaxIterations:   1,
+	})
+	if err != nil {
+		t.Fatalf("await decision: %v", err)
+	}
+	if response.Decision != ApprovalDecisionApproved {
+		t.Fatalf("decision = %q, want %q", response.Decision, ApprovalDecisionApproved)
+	}
+
+	if beads.stateID != "commission-1" || beads.commentID != "commission-1" {
+		t.Fatalf("persisted to bead (%q, %q), want commission-1", beads.stateID, beads.commentID)
+	}
+	wantStates := map[string]string{
+		"approval.manifest.decision":   "Approved",
+		"approval.manifest.actor":      "captain-jc",
+		"approval.manifest.decided_at": "2026-02-10T09:30:00Z",
+	}
+	for key, want := range wantStates {
+		if got := beads.states[key]; got != want {
+			t.Fatalf("state %s = %q, want %q", key, got, want)
+		}
+	}
+	if len(beads.comments) != 1 || !strings.Contains(beads.comments[0], "manifest approval: Approved by captain-jc at 2026-02-10T09:30:00Z") {
+		t.Fatalf("comments = %q, want approval audit comment", beads.comments)
+	}
+}
+
+func TestBeadsApprovalRecorderDefaultsActorForWaveReview(t *testing.T) {
+	t.Parallel()
+
+	beads := &fakeApprovalBeads{states: make(map[string]string)}
+	recorder, err := NewBeadsApprovalRecorder(beads)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+
+	err = recorder.RecordApproval(context.Background(), ApprovalRecord{
+		Request: ApprovalRequest{
+			CommissionID: "commission-1",
+			WaveReview:   &WaveReview{WaveIndex: 2},
+		},
+		Response:   ApprovalResponse{Decision: ApprovalDecisionFeedback, FeedbackText: "tighten copy"},
+		AnsweredAt: time.Date(2026, 2, 10, 9, 30, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("record approval: %v", err)
+	}
+	if got := beads.states["approval.wave_2.actor"]; got != "admiral" {
+		t.Fatalf("actor = %q, want admiral", got)
+	}
+	if len(beads.comments) != 1 || !strings.HasSuffix(beads.comments[0], "(feedback: tighten copy)") {
+		t.Fatalf("comments = %q, want feedback in audit comment", beads.comments)
+	}
+}
+
+type fakeApprovalBeads struct {
+	states    map[string]string
+	stateID   string
+	comments  []string
+	commentID string
+}
+
+func (f *fakeApprovalBeads) SetState(id, key, value string) error {
+	f.stateID = id
+	f.states[key] = value
+	return nil
+}
+
+func (f *fakeApprovalBeads) AddComment(id, comment string) error {
+	f.commentID = id
+	f.comments = append(f.comments, comment)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -11,6 +12,14 @@ import (
 
 const defaultGateBuffer = 1
 
+// questionEnqueueRetry is how long Ask waits before retrying to surface a question while
+// the Questions channel is full.
+const questionEnqueueRetry = 10 * time.Millisecond
+
+// ErrQuestionNotPending is returned by SubmitAnswer when no Ask is waiting on the question,
+// for example because it already timed out or was answered.
+var ErrQuestionNotPending = errors.New("question is not pending")
+
 // AdmiralQuestion is the normalized question payload sent from a planning agent to the Admiral.
 //
 //nolint:revive // Field names are specified by the issue contract.
@@ -23,6 +32,11 @@ type AdmiralQuestion struct {
 	Options        []string
 	AllowFreeText  bool
 	AllowBroadcast bool
+	// AllowSkip lets an unanswered question be skipped when the Admiral does not respond in time.
+	AllowSkip bool
+	// DefaultOption is selected when the Admiral does not respond in time. It must be one of
+	// Options.
+	DefaultOption string
 }
 
 // AdmiralAnswer is the Admiral's response payload for a question.
@@ -43,16 +57,29 @@ type QuestionRecord struct {
 	Answer     AdmiralAnswer
 	AskedAt    time.Time
 	AnsweredAt time.Time
+	// TimedOut marks answers chosen automatically because the Admiral did not respond in time.
+	TimedOut bool
 }
 
 // QuestionGate is a channel-based gate that blocks planning progress until an Admiral answer arrives.
 type QuestionGate struct {
 	questions chan AdmiralQuestion
-	answers   chan AdmiralAnswer
 	now       func() time.Time
 
-	mu      sync.Mutex
-	history []QuestionRecord
+	mu       sync.Mutex
+	history  []QuestionRecord
+	pending  []AdmiralQuestion
+	answers  map[string]chan AdmiralAnswer
+	recorder QuestionRecorder
+}
+
+// QuestionRecorder durably persists surfaced questions and their resolution so processes
+// other than the asker can see which questions are pending.
+type QuestionRecorder interface {
+	RecordQuestionAsked(ctx context.Context, question AdmiralQuestion, askedAt time.Time) error
+	// RecordQuestionResolved receives a record with an empty Answer when the asker stopped
+	// waiting before any answer arrived.
+	RecordQuestionResolved(ctx context.Context, record QuestionRecord) error
 }
 
 // NewQuestionGate constructs a new blocking Admiral question gate.
@@ -62,32 +89,51 @@ func NewQuestionGate(bufferSize int) *QuestionGate {
 	}
 	return &QuestionGate{
 		questions: make(chan AdmiralQuestion, bufferSize),
-		answers:   make(chan AdmiralAnswer, bufferSize),
 		now:       time.Now,
+		answers:   make(map[string]chan AdmiralAnswer),
 		history:   make([]QuestionRecord, 0),
 	}
 }
 
+// SetRecorder persists every subsequent question and resolution through recorder; nil
+// disables persistence.
+func (g *QuestionGate) SetRecorder(recorder QuestionRecorder) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.recorder = recorder
+}
+
 // Questions exposes surfaced Admiral questions for subscribers (for example, TUI modal handling).
+// A question whose asker stops waiting before any subscriber receives it is withdrawn.
 func (g *QuestionGate) Questions() <-chan AdmiralQuestion {
 	return g.questions
 }
 
-// SubmitAnswer publishes one Admiral answer into the gate.
+// SubmitAnswer delivers one Admiral answer to the Ask waiting on its question. It never
+// blocks: answers for questions that timed out, were withdrawn, or already have an answer
+// are rejected with ErrQuestionNotPending.
 func (g *QuestionGate) SubmitAnswer(answer AdmiralAnswer) error {
 	if g == nil {
 		return errors.New("question gate is nil")
 	}
 
-	answer.QuestionID = strings.TrimSpace(answer.QuestionID)
-	answer.SelectedOption = strings.TrimSpace(answer.SelectedOption)
-	answer.FreeText = strings.TrimSpace(answer.FreeText)
+	answer = normalizeAnswer(answer)
 	if answer.QuestionID == "" {
 		return errors.New("question id is required")
 	}
 
-	g.answers <- answer
-	return nil
+	g.mu.Lock()
+	answers, ok := g.answers[answer.QuestionID]
+	g.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrQuestionNotPending, answer.QuestionID)
+	}
+	select {
+	case answers <- answer:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s already has an answer", ErrQuestionNotPending, answer.QuestionID)
+	}
 }
 
 // Ask surfaces a question and blocks until the matching answer is received or context is canceled.
@@ -101,36 +147,143 @@ func (g *QuestionGate) Ask(ctx context.Context, question AdmiralQuestion) (Admir
 		return AdmiralAnswer{}, err
 	}
 	askedAt := g.now().UTC()
+	answers, err := g.addPending(normalized)
+	if err != nil {
+		return AdmiralAnswer{}, err
+	}
+	defer g.removePending(normalized.QuestionID)
+
+	recorder := g.currentRecorder()
+	if recorder != nil {
+		if err := recorder.RecordQuestionAsked(ctx, normalized, askedAt); err != nil {
+			return AdmiralAnswer{}, fmt.Errorf("record admiral question: %w", err)
+		}
+	}
+
+	for !g.enqueue(normalized) {
+		select {
+		case <-time.After(questionEnqueueRetry):
+		case <-ctx.Done():
+			g.recordWithdrawn(ctx, recorder, normalized, askedAt)
+			return AdmiralAnswer{}, ctx.Err()
+		}
+	}
 
 	select {
-	case g.questions <- normalized:
+	case answer := <-answers:
+		record := QuestionRecord{
+			QuestionID: normalized.QuestionID,
+			Question:   normalized,
+			Answer:     answer,
+			AskedAt:    askedAt,
+			AnsweredAt: g.now().UTC(),
+		}
+		g.mu.Lock()
+		g.history = append(g.history, record)
+		g.mu.Unlock()
+		if recorder != nil {
+			if err := recorder.RecordQuestionResolved(ctx, record); err != nil {
+				return answer, fmt.Errorf("record admiral answer: %w", err)
+			}
+		}
+
+		return answer, nil
 	case <-ctx.Done():
+		g.withdrawQueued(normalized.QuestionID)
+		g.recordWithdrawn(ctx, recorder, normalized, askedAt)
 		return AdmiralAnswer{}, ctx.Err()
 	}
+}
+
+// enqueue surfaces question on the Questions channel without blocking and reports whether
+// it fit. Sends only happen under g.mu so withdrawQueued can drain and requeue atomically.
+func (g *QuestionGate) enqueue(question AdmiralQuestion) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	select {
+	case g.questions <- question:
+		return true
+	default:
+		return false
+	}
+}
+
+// withdrawQueued removes a question nobody has received yet from the Questions channel so
+// subscribers never prompt the Admiral for an answer that can no longer be delivered. Other
+// queued questions keep their order. Every send holds g.mu, so the drained questions always
+// fit back into the channel they came from.
+func (g *QuestionGate) withdrawQueued(questionID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	for {
+	kept := make([]AdmiralQuestion, 0, len(g.questions))
+	for drained := false; !drained; {
 		select {
-		case answer := <-g.answers:
-			answer = normalizeAnswer(answer)
-			if answer.QuestionID != normalized.QuestionID {
-				continue
-			}
-			record := QuestionRecord{
-				QuestionID: normalized.QuestionID,
-				Question:   normalized,
-				Answer:     answer,
-				AskedAt:    askedAt,
-				AnsweredAt: g.now().UTC(),
+		case question := <-g.questions:
+			if question.QuestionID != questionID {
+				kept = append(kept, question)
 			}
-			g.mu.Lock()
-			g.history = append(g.history, record)
-			g.mu.Unlock()
+		default:
+			drained = true
+		}
+	}
+	for _, question := range kept {
+		g.questions <- question
+	}
+}
 
-			return answer, nil
-		case <-ctx.Done():
-			return AdmiralAnswer{}, ctx.Err()
+// recordWithdrawn best-effort persists that the asker stopped waiting for question. A
+// timed-out question with a fallback answer is resolved again by RecordTimedOut.
+func (g *QuestionGate) recordWithdrawn(ctx context.Context, recorder QuestionRecorder, question AdmiralQuestion, askedAt time.Time) {
+	if recorder == nil {
+		return
+	}
+	_ = recorder.RecordQuestionResolved(context.WithoutCancel(ctx), QuestionRecord{
+		QuestionID: question.QuestionID,
+		Question:   question,
+		AskedAt:    askedAt,
+		AnsweredAt: g.now().UTC(),
+	})
+}
+
+func (g *QuestionGate) currentRecorder() QuestionRecorder {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.recorder
+}
+
+// RecordTimedOut persists an automatically chosen answer for a question the Admiral did
+// not answer in time.
+func (g *QuestionGate) RecordTimedOut(ctx context.Context, question AdmiralQuestion, answer AdmiralAnswer, askedAt time.Time) error {
+	if g == nil {
+		return errors.New("question gate is nil")
+	}
+
+	normalized, err := normalizeQuestion(question)
+	if err != nil {
+		return err
+	}
+	answer = normalizeAnswer(answer)
+	answer.QuestionID = normalized.QuestionID
+
+	record := QuestionRecord{
+		QuestionID: normalized.QuestionID,
+		Question:   normalized,
+		Answer:     answer,
+		AskedAt:    askedAt.UTC(),
+		AnsweredAt: g.now().UTC(),
+		TimedOut:   true,
+	}
+	g.mu.Lock()
+	g.history = append(g.history, record)
+	recorder := g.recorder
+	g.mu.Unlock()
+	if recorder != nil {
+		if err := recorder.RecordQuestionResolved(ctx, record); err != nil {
+			return fmt.Errorf("record timed-out answer: %w", err)
 		}
 	}
+	return nil
 }
 
 // History returns a copy of persisted question/answer records.
@@ -147,12 +300,54 @@ func (g *QuestionGate) History() []QuestionRecord {
 	return history
 }
 
+// PendingQuestions returns asked-but-unanswered questions in the order they were asked.
+func (g *QuestionGate) PendingQuestions() []AdmiralQuestion {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	pending := make([]AdmiralQuestion, 0, len(g.pending))
+	for _, question := range g.pending {
+		question.Options = append([]string(nil), question.Options...)
+		pending = append(pending, question)
+	}
+	return pending
+}
+
+func (g *QuestionGate) addPending(question AdmiralQuestion) (chan AdmiralAnswer, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, exists := g.answers[question.QuestionID]; exists {
+		return nil, fmt.Errorf("question %s is already pending", question.QuestionID)
+	}
+	answers := make(chan AdmiralAnswer, 1)
+	g.answers[question.QuestionID] = answers
+	g.pending = append(g.pending, question)
+	return answers, nil
+}
+
+func (g *QuestionGate) removePending(questionID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.answers, questionID)
+	for i, question := range g.pending {
+		if question.QuestionID == questionID {
+			g.pending = append(g.pending[:i], g.pending[i+1:]...)
+			return
+		}
+	}
+}
+
 func normalizeQuestion(question AdmiralQuestion) (AdmiralQuestion, error) {
 	question.QuestionID = strings.TrimSpace(question.QuestionID)
 	question.AskingAgent = strings.TrimSpace(question.AskingAgent)
 	question.MissionID = strings.TrimSpace(question.MissionID)
 	question.Domain = strings.TrimSpace(question.Domain)
 	question.QuestionText = strings.TrimSpace(question.QuestionText)
+	question.DefaultOption = strings.TrimSpace(question.DefaultOption)
 	if question.QuestionID == "" {
 		return AdmiralQuestion{}, errors.New("question id is required")
 	}
@@ -172,6 +367,9 @@ func normalizeQuestion(question AdmiralQuestion) (AdmiralQuestion, error) {
 		options = append(options, option)
 	}
 	question.Options = options
+	if question.DefaultOption != "" && !slices.Contains(question.Options, question.DefaultOption) {
+		return AdmiralQuestion{}, fmt.Errorf("default option %q not found in question options", question.DefaultOption)
+	}
 
 	return question, nil
 }
@@ -183,6 +381,22 @@ func normalizeAnswer(answer AdmiralAnswer) AdmiralAnswer {
 	return answer
 }
 
+// DefaultAnswer returns the answer to use when the Admiral does not respond in time: the
+// question's default option when set, otherwise a skip when the question allows skipping.
+// It reports false when the question has neither.
+func DefaultAnswer(question AdmiralQuestion) (AdmiralAnswer, bool) {
+	answer := AdmiralAnswer{QuestionID: strings.TrimSpace(question.QuestionID)}
+	if option := strings.TrimSpace(question.DefaultOption); option != "" {
+		answer.SelectedOption = option
+		return answer, true
+	}
+	if question.AllowSkip {
+		answer.SkipFlag = true
+		return answer, true
+	}
+	return AdmiralAnswer{}, false
+}
+
 // ValidateAnswer checks whether an answer shape is valid for a specific question.
 func ValidateAnswer(question AdmiralQuestion, answer AdmiralAnswer) error {
 	question, err := normalizeQuestion(question)
@@ -2,6 +2,8 @@ package admiral
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -65,6 +67,55 @@ func TestQuestionGateAskBlocksUntilMatchingAnswerAndPersistsHistory(t *testing.T
 	}
 }
 
+func TestQuestionGatePendingQuestionsTracksUnansweredQuestions(t *testing.T) {
+	t.Parallel()
+
+	gate := NewQuestionGate(2)
+	cancels := make(map[string]context.CancelFunc, 2)
+	results := make(map[string]chan error, 2)
+	for _, id := range []string{"Q-1", "Q-2"} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancels[id] = cancel
+		results[id] = make(chan error, 1)
+
+		question := AdmiralQuestion{QuestionID: id, AskingAgent: "captain", QuestionText: "Question " + id}
+		go func(result chan<- error) {
+			_, err := gate.Ask(ctx, question)
+			result <- err
+		}(results[id])
+		select {
+		case <-gate.Questions():
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for surfaced question %s", id)
+		}
+	}
+
+	pending := gate.PendingQuestions()
+	if len(pending) != 2 || pending[0].QuestionID != "Q-1" || pending[1].QuestionID != "Q-2" {
+		t.Fatalf("pending questions = %+v, want Q-1 and Q-2", pending)
+	}
+
+	cancels["Q-1"]()
+	if err := <-results["Q-1"]; err == nil {
+		t.Fatal("expected Q-1 ask to fail after cancellation")
+	}
+	pending = gate.PendingQuestions()
+	if len(pending) != 1 || pending[0].QuestionID != "Q-2" {
+		t.Fatalf("pending questions = %+v, want only Q-2", pending)
+	}
+
+	if err := gate.SubmitAnswer(AdmiralAnswer{QuestionID: "Q-2", SkipFlag: true}); err != nil {
+		t.Fatalf("submit answer: %v", err)
+	}
+	if err := <-results["Q-2"]; err != nil {
+		t.Fatalf("ask Q-2: %v", err)
+	}
+	if pending := gate.PendingQuestions(); len(pending) != 0 {
+		t.Fatalf("pending questions after answer = %+v, want none", pending)
+	}
+}
+
 func TestValidateAnswerSupportsOptionFreeTextAndSkip(t *testing.T) {
 	t.Parallel()
 
@@ -115,3 +166,124 @@ func TestValidateAnswerSupportsOptionFreeTextAndSkip(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAnswerPrefersDefaultOptionThenSkip(t *testing.T) {
+	t.Parallel()
+
+	withDefault := AdmiralQuestion{QuestionID: "Q-1", DefaultOption: " fast ", AllowSkip: true}
+	if answer, ok := DefaultAnswer(withDefault); !ok || answer.SelectedOption != "fast" || answer.SkipFlag {
+		t.Fatalf("default answer = %+v, %v; want selected option fast", answer, ok)
+	}
+
+	skippable := AdmiralQuestion{QuestionID: "Q-2", AllowSkip: true}
+	if answer, ok := DefaultAnswer(skippable); !ok || !answer.SkipFlag || answer.QuestionID != "Q-2" {
+		t.Fatalf("default answer = %+v, %v; want skip for Q-2", answer, ok)
+	}
+
+	if _, ok := DefaultAnswer(AdmiralQuestion{QuestionID: "Q-3"}); ok {
+		t.Fatal("expected no default answer for a question without default or skip")
+	}
+}
+
+func TestQuestionGateWithdrawsTimedOutQuestionAndRejectsLateAnswers(t *testing.T) {
+	t.Parallel()
+
+	gate := NewQuestionGate(2)
+	waiting := make(chan error, 1)
+	go func() {
+		_, err := gate.Ask(context.Background(), AdmiralQuestion{QuestionID: "Q-1", AskingAgent: "captain", QuestionText: "Still waiting?"})
+		waiting <- err
+	}()
+	for len(gate.PendingQuestions()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := gate.Ask(ctx, AdmiralQuestion{QuestionID: "Q-2", AskingAgent: "captain", QuestionText: "Timed out?"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ask error = %v, want deadline exceeded", err)
+	}
+
+	select {
+	case question := <-gate.Questions():
+		if question.QuestionID != "Q-1" {
+			t.Fatalf("queued question = %s, want only Q-1 after Q-2 timed out", question.QuestionID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for Q-1")
+	}
+	select {
+	case question := <-gate.Questions():
+		t.Fatalf("timed-out question %s still queued", question.QuestionID)
+	default:
+	}
+
+	for range 2 {
+		if err := gate.SubmitAnswer(AdmiralAnswer{QuestionID: "Q-2", SkipFlag: true}); !errors.Is(err, ErrQuestionNotPending) {
+			t.Fatalf("late answer error = %v, want ErrQuestionNotPending", err)
+		}
+	}
+	if err := gate.SubmitAnswer(AdmiralAnswer{QuestionID: "Q-1", SkipFlag: true}); err != nil {
+		t.Fatalf("submit Q-1 answer: %v", err)
+	}
+	if err := <-waiting; err != nil {
+		t.Fatalf("ask Q-1: %v", err)
+	}
+	if err := gate.SubmitAnswer(AdmiralAnswer{QuestionID: "Q-1", SkipFlag: true}); !errors.Is(err, ErrQuestionNotPending) {
+		t.Fatalf("duplicate answer error = %v, want ErrQuestionNotPending", err)
+	}
+}
+
+func TestQuestionGateAskRejectsDefaultOptionOutsideOptions(t *testing.T) {
+	t.Parallel()
+
+	gate := NewQuestionGate(1)
+	_, err := gate.Ask(context.Background(), AdmiralQuestion{
+		QuestionID:    "Q-1",
+		AskingAgent:   "captain",
+		QuestionText:  "Which cache?",
+		Options:       []string{"redis", "memcached"},
+		DefaultOption: "sqlite",
+	})
+	if err == nil || !strings.Contains(err.Error(), `default option "sqlite"`) {
+		t.Fatalf("ask error = %v, want unknown default option rejected", err)
+	}
+	if pending := gate.PendingQuestions(); len(pending) != 0 {
+		t.Fatalf("pending questions = %+v, want none after rejected ask", pending)
+	}
+}
+
+func TestQuestionGateWithdrawFromFullQueueKeepsOtherQuestions(t *testing.T) {
+	t.Parallel()
+
+	gate := NewQuestionGate(1)
+	first := make(chan error, 1)
+	go func() {
+		_, err := gate.Ask(context.Background(), AdmiralQuestion{QuestionID: "Q-1", AskingAgent: "captain", QuestionText: "First?"})
+		first <- err
+	}()
+	for len(gate.PendingQuestions()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The queue is full with Q-1, so Q-2 times out before it is ever surfaced.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := gate.Ask(ctx, AdmiralQuestion{QuestionID: "Q-2", AskingAgent: "captain", QuestionText: "Second?"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ask Q-2 error = %v, want deadline exceeded", err)
+	}
+	gate.withdrawQueued("Q-2")
+
+	if got := len(gate.Questions()); got != 1 {
+		t.Fatalf("queued questions = %d, want only Q-1", got)
+	}
+	if question := <-gate.Questions(); question.QuestionID != "Q-1" {
+		t.Fatalf("queued question = %s, want Q-1", question.QuestionID)
+	}
+	if err := gate.SubmitAnswer(AdmiralAnswer{QuestionID: "Q-1", SkipFlag: true}); err != nil {
+		t.Fatalf("submit Q-1 answer: %v", err)
+	}
+	if err := <-first; err != nil {
+		t.Fatalf("ask Q-1: %v", err)
+	}
+}
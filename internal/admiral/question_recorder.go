@@ -0,0 +1,133 @@
+package admiral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuestionCommentPrefix marks the structured commission bead comments written by
+// BeadsQuestionRecorder.
+const QuestionCommentPrefix = "admiral-question: "
+
+const (
+	questionStatusAsked     = "asked"
+	questionStatusAnswered  = "answered"
+	questionStatusTimedOut  = "timed_out"
+	questionStatusWithdrawn = "withdrawn"
+)
+
+type beadsQuestionWriter interface {
+	AddComment(id, comment string) error
+}
+
+// questionComment is the JSON payload of one question lifecycle comment.
+type questionComment struct {
+	QuestionID   string `json:"question_id"`
+	Status       string `json:"status"`
+	AskingAgent  string `json:"asking_agent,omitempty"`
+	MissionID    string `json:"mission_id,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+	QuestionText string `json:"question_text,omitempty"`
+	At           string `json:"at"`
+}
+
+// BeadsQuestionRecorder persists Admiral question lifecycle changes as structured comments
+// on the commission bead, so processes other than the planner can list pending questions.
+type BeadsQuestionRecorder struct {
+	beads        beadsQuestionWriter
+	commissionID string
+}
+
+// NewBeadsQuestionRecorder creates a QuestionRecorder that writes to the commission bead.
+func NewBeadsQuestionRecorder(beads beadsQuestionWriter, commissionID string) (*BeadsQuestionRecorder, error) {
+	if beads == nil {
+		return nil, errors.New("beads client is required")
+	}
+	commissionID = strings.TrimSpace(commissionID)
+	if commissionID == "" {
+		return nil, errors.New("commission id must not be empty")
+	}
+	return &BeadsQuestionRecorder{beads: beads, commissionID: commissionID}, nil
+}
+
+// RecordQuestionAsked records a question surfaced to the Admiral.
+func (r *BeadsQuestionRecorder) RecordQuestionAsked(_ context.Context, question AdmiralQuestion, askedAt time.Time) error {
+	return r.write(questionComment{
+		QuestionID:   question.QuestionID,
+		Status:       questionStatusAsked,
+		AskingAgent:  question.AskingAgent,
+		MissionID:    question.MissionID,
+		Domain:       question.Domain,
+		QuestionText: question.QuestionText,
+		At:           askedAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// RecordQuestionResolved records that a question is no longer pending: answered, answered
+// automatically after a timeout, or withdrawn when the asker stopped waiting.
+func (r *BeadsQuestionRecorder) RecordQuestionResolved(_ context.Context, record QuestionRecord) error {
+	status := questionStatusAnswered
+	switch {
+	case record.TimedOut:
+		status = questionStatusTimedOut
+	case record.Answer.QuestionID == "":
+		status = questionStatusWithdrawn
+	}
+	return r.write(questionComment{
+		QuestionID: record.QuestionID,
+		Status:     status,
+		At:         record.AnsweredAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+func (r *BeadsQuestionRecorder) write(comment questionComment) error {
+	body, err := json.Marshal(comment)
+	if err != nil {
+		return fmt.Errorf("marshal question comment: %w", err)
+	}
+	if err := r.beads.AddComment(r.commissionID, QuestionCommentPrefix+string(body)); err != nil {
+		return fmt.Errorf("add question comment: %w", err)
+	}
+	return nil
+}
+
+// PendingQuestionsFromComments returns the questions recorded by BeadsQuestionRecorder that
+// have no later resolution, in the order they were asked. Other comments are ignored.
+func PendingQuestionsFromComments(comments []string) ([]AdmiralQuestion, error) {
+	pending := make([]AdmiralQuestion, 0)
+	for i, raw := range comments {
+		raw = strings.TrimSpace(raw)
+		if !strings.HasPrefix(raw, QuestionCommentPrefix) {
+			continue
+		}
+		var comment questionComment
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(raw, QuestionCommentPrefix)), &comment); err != nil {
+			return nil, fmt.Errorf("decode question comment %d: %w", i, err)
+		}
+
+		pending = removeQuestion(pending, comment.QuestionID)
+		if comment.Status == questionStatusAsked {
+			pending = append(pending, AdmiralQuestion{
+				QuestionID:   comment.QuestionID,
+				AskingAgent:  comment.AskingAgent,
+				MissionID:    comment.MissionID,
+				Domain:       comment.Domain,
+				QuestionText: comment.QuestionText,
+			})
+		}
+	}
+	return pending, nil
+}
+
+func removeQuestion(questions []AdmiralQuestion, questionID string) []AdmiralQuestion {
+	for i, question := range questions {
+		if question.QuestionID == questionID {
+			return append(questions[:i], questions[i+1:]...)
+		}
+	}
+	return questions
+}
@@ -0,0 +1,51 @@
+package admiral
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQuestionGatePersistsPendingQuestionsToBeads(t *testing.T) {
+	t.Parallel()
+
+	beads := &fakeApprovalBeads{states: make(map[string]string)}
+	recorder, err := NewBeadsQuestionRecorder(beads, "commission-1")
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	gate := NewQuestionGate(1)
+	gate.SetRecorder(recorder)
+
+	go func() {
+		question := <-gate.Questions()
+		_ = gate.SubmitAnswer(AdmiralAnswer{QuestionID: question.QuestionID, SkipFlag: true})
+	}()
+	if _, err := gate.Ask(context.Background(), AdmiralQuestion{QuestionID: "q-1", AskingAgent: "captain", QuestionText: "Answered?"}); err != nil {
+		t.Fatalf("ask answered question: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := gate.Ask(ctx, AdmiralQuestion{QuestionID: "q-2", AskingAgent: "captain", QuestionText: "Withdrawn?"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ask withdrawn question error = %v, want deadline exceeded", err)
+	}
+
+	pendingQuestion := AdmiralQuestion{QuestionID: "q-3", AskingAgent: "commander", MissionID: "M-1", QuestionText: "Which database?"}
+	if err := recorder.RecordQuestionAsked(context.Background(), pendingQuestion, time.Now()); err != nil {
+		t.Fatalf("record pending question: %v", err)
+	}
+
+	if beads.commentID != "commission-1" {
+		t.Fatalf("comments written to %q, want commission-1", beads.commentID)
+	}
+	pending, err := PendingQuestionsFromComments(append([]string{"unrelated note"}, beads.comments...))
+	if err != nil {
+		t.Fatalf("pending questions from comments: %v", err)
+	}
+	if !reflect.DeepEqual(pending, []AdmiralQuestion{pendingQuestion}) {
+		t.Fatalf("pending questions = %+v, want only %+v", pending, pendingQuestion)
+	}
+}
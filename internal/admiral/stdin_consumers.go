@@ -108,6 +108,9 @@ func (r *lockedReader) Read(p []byte) (int, error) {
 }
 
 func readApprovalResponse(reader *bufio.Reader, output io.Writer, request ApprovalRequest) ApprovalResponse {
+	if request.Escalation != nil {
+		return readEscalationResponse(reader, output, request)
+	}
 	if request.WaveReview != nil {
 		renderWaveReviewPrompt(output, request)
 		choice := strings.ToLower(strings.TrimSpace(readLine(reader)))
@@ -136,6 +139,28 @@ func readApprovalResponse(reader *bufio.Reader, output io.Writer, request Approv
 	}
 }
 
+// readEscalationResponse requires an explicit choice for a reviewer escalation, re-prompting on
+// anything else so a typo never approves a mission. Closed input halts the mission.
+func readEscalationResponse(reader *bufio.Reader, output io.Writer, request ApprovalRequest) ApprovalResponse {
+	for {
+		renderEscalationPrompt(output, request)
+		line, err := reader.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "a", "approve":
+			return ApprovalResponse{Decision: ApprovalDecisionApproved}
+		case "h", "halt":
+			return ApprovalResponse{Decision: ApprovalDecisionHalted, FeedbackText: strings.TrimSpace(readMultiline(reader, output, "halt reason (optional):"))}
+		case "f", "feedback":
+			feedback := strings.TrimSpace(readMultiline(reader, output, "feedback (blank line to finish):"))
+			return ApprovalResponse{Decision: ApprovalDecisionFeedback, FeedbackText: feedback}
+		}
+		if err != nil {
+			return ApprovalResponse{Decision: ApprovalDecisionHalted, FeedbackText: "admiral input closed before an escalation decision"}
+		}
+		writef(output, "unrecognized choice %q\n", strings.TrimSpace(line))
+	}
+}
+
 func readQuestionAnswer(reader *bufio.Reader, output io.Writer, question AdmiralQuestion) AdmiralAnswer {
 	renderQuestionPrompt(output, question)
 	line := strings.TrimSpace(readLine(reader))
@@ -181,13 +206,36 @@ func renderApprovalPrompt(output io.Writer, request ApprovalRequest) {
 func renderWaveReviewPrompt(output io.Writer, request ApprovalRequest) {
 	writef(output, "Wave review for commission %s wave %d\n", request.CommissionID, request.WaveReview.WaveIndex)
 	writeln(output, "Demo tokens:")
-	for missionID := range request.WaveReview.DemoTokens {
+	for _, missionID := range SortedDemoTokenIDs(request.WaveReview.DemoTokens) {
 		writef(output, "- %s\n", missionID)
 	}
+	if len(request.WaveReview.HaltedMissions) > 0 {
+		writeln(output, "Halted missions:")
+		for _, missionID := range request.WaveReview.HaltedMissions {
+			writef(output, "- %s\n", missionID)
+		}
+	}
 	writeln(output, "Choose: [c]ontinue, [f]eedback, [h]alt")
 	write(output, "> ")
 }
 
+func renderEscalationPrompt(output io.Writer, request ApprovalRequest) {
+	escalation := request.Escalation
+	writef(
+		output,
+		"Reviewer escalation for commission %s mission %s (wave %d, revision %d)\n",
+		request.CommissionID,
+		escalation.MissionID,
+		escalation.WaveIndex,
+		escalation.RevisionCount,
+	)
+	if escalation.Reason != "" {
+		writef(output, "Reason: %s\n", escalation.Reason)
+	}
+	writeln(output, "Choose: [a]pprove, [f]eedback, [h]alt")
+	write(output, "> ")
+}
+
 func renderQuestionPrompt(output io.Writer, question AdmiralQuestion) {
 	writef(output, "Question (%s) from %s [domain=%s]\n", question.QuestionID, question.AskingAgent, question.Domain)
 	writeln(output, question.QuestionText)
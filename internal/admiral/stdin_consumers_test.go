@@ -3,6 +3,7 @@ package admiral
 import (
 	"bytes"
 	"context"
+	"strings"
 	"testing"
 	"time"
 )
@@ -80,6 +81,91 @@ func TestWaveReviewPromptUsesContinueFeedbackHalt(t *testing.T) {
 	}
 }
 
+func TestEscalationPromptShowsReviewerReason(t *testing.T) {
+	t.Parallel()
+
+	gate := NewApprovalGate(1)
+	output := &bytes.Buffer{}
+	input := bytes.NewBufferString("f\ncap retries at two\n\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	StartApprovalGateStdioConsumer(ctx, gate, input, output)
+
+	resp, err := gate.AwaitDecision(context.Background(), ApprovalRequest{
+		CommissionID:    "C-ESC",
+		MissionManifest: []Mission{{ID: "M-1", Title: "One", Classification: "RED_ALERT"}},
+		Iteration:       1,
+		MaxIterations:   1,
+		Escalation: &ReviewEscalation{
+			MissionID:     " M-1 ",
+			WaveIndex:     2,
+			RevisionCount: 1,
+			Reason:        "spec is ambiguous about retries",
+		},
+	})
+	if err != nil {
+		t.Fatalf("await escalation: %v", err)
+	}
+	if resp.Decision != ApprovalDecisionFeedback || resp.FeedbackText != "cap retries at two" {
+		t.Fatalf("response = %+v, want feedback decision", resp)
+	}
+	for _, want := range []string{"Reviewer escalation for commission C-ESC mission M-1 (wave 2, revision 1)", "Reason: spec is ambiguous about retries", "[a]pprove, [f]eedback, [h]alt"} {
+		if !strings.Contains(output.String(), want) {
+			t.Fatalf("prompt missing %q:\n%s", want, output.String())
+		}
+	}
+}
+
+func TestEscalationPromptRepromptsUntilExplicitChoice(t *testing.T) {
+	t.Parallel()
+
+	gate := NewApprovalGate(1)
+	output := &bytes.Buffer{}
+	input := bytes.NewBufferString("ok\n\na\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	StartApprovalGateStdioConsumer(ctx, gate, input, output)
+
+	resp, err := gate.AwaitDecision(context.Background(), ApprovalRequest{
+		CommissionID:    "C-ESC",
+		MissionManifest: []Mission{{ID: "M-1", Title: "One", Classification: "RED_ALERT"}},
+		Escalation:      &ReviewEscalation{MissionID: "M-1", WaveIndex: 1},
+	})
+	if err != nil {
+		t.Fatalf("await escalation: %v", err)
+	}
+	if resp.Decision != ApprovalDecisionApproved {
+		t.Fatalf("decision = %q, want %q after explicit approve", resp.Decision, ApprovalDecisionApproved)
+	}
+	if got := strings.Count(output.String(), "Reviewer escalation for commission"); got != 3 {
+		t.Fatalf("escalation prompts = %d, want 3 (two invalid inputs re-prompted):\n%s", got, output.String())
+	}
+	if !strings.Contains(output.String(), `unrecognized choice "ok"`) {
+		t.Fatalf("output missing unrecognized choice notice:\n%s", output.String())
+	}
+}
+
+func TestEscalationPromptHaltsWhenInputCloses(t *testing.T) {
+	t.Parallel()
+
+	gate := NewApprovalGate(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	StartApprovalGateStdioConsumer(ctx, gate, strings.NewReader("yes\n"), &bytes.Buffer{})
+
+	resp, err := gate.AwaitDecision(context.Background(), ApprovalRequest{
+		CommissionID:    "C-ESC",
+		MissionManifest: []Mission{{ID: "M-1", Title: "One", Classification: "RED_ALERT"}},
+		Escalation:      &ReviewEscalation{MissionID: "M-1", WaveIndex: 1},
+	})
+	if err != nil {
+		t.Fatalf("await escalation: %v", err)
+	}
+	if resp.Decision != ApprovalDecisionHalted {
+		t.Fatalf("decision = %q, want %q when input closes without a valid choice", resp.Decision, ApprovalDecisionHalted)
+	}
+}
+
 func TestQuestionConsumerSupportsOptionAndFreeText(t *testing.T) {
 	t.Parallel()
 
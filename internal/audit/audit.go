@@ -0,0 +1,268 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/commission"
+	"github.com/ship-commander/sc3/internal/protocol"
+	"github.com/ship-commander/sc3/internal/telemetry"
+)
+
+// ArchiveVersion identifies the audit archive layout so consumers can detect format changes.
+const ArchiveVersion = "1"
+
+const redactedValue = "<redacted>"
+
+// sensitivePayloadKeys are normalized event payload keys whose string values are always redacted.
+var sensitivePayloadKeys = map[string]struct{}{
+	"api_key":       {},
+	"apikey":        {},
+	"access_token":  {},
+	"token":         {},
+	"password":      {},
+	"secret":        {},
+	"authorization": {},
+}
+
+// Approval is one Admiral decision recorded against a commission.
+type Approval struct {
+	Subject   string    `json:"subject"`
+	Decision  string    `json:"decision"`
+	Actor     string    `json:"actor"`
+	DecidedAt time.Time `json:"decidedAt"`
+	Feedback  string    `json:"feedback,omitempty"`
+}
+
+// CommissionRecord is the commission header and PRD in an archive.
+type CommissionRecord struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	PRD    string `json:"prd"`
+}
+
+// MissionRecord holds one mission's protocol events and demo token in an archive.
+type MissionRecord struct {
+	ID        string                   `json:"id"`
+	Title     string                   `json:"title,omitempty"`
+	Events    []protocol.ProtocolEvent `json:"events"`
+	DemoToken string                   `json:"demoToken,omitempty"`
+}
+
+// Archive is the structured audit trail for one commission.
+type Archive struct {
+	Version    string               `json:"version"`
+	ExportedAt time.Time            `json:"exportedAt"`
+	Commission CommissionRecord     `json:"commission"`
+	Plan       commission.PlanState `json:"plan"`
+	Approvals  []Approval           `json:"approvals"`
+	Missions   []MissionRecord      `json:"missions"`
+}
+
+// CommissionStore loads a persisted commission, including its PRD content.
+type CommissionStore interface {
+	LoadCommission(ctx context.Context, commissionID string) (commission.Commission, error)
+}
+
+// PlanStore loads the persisted plan result for a commission.
+type PlanStore interface {
+	LoadPlan(ctx context.Context, commissionID string) (commission.PlanState, error)
+}
+
+// ApprovalStore lists the Admiral approvals recorded for a commission, oldest first.
+type ApprovalStore interface {
+	ListApprovals(ctx context.Context, commissionID string) ([]Approval, error)
+}
+
+// DemoTokenStore reads a mission's demo token; it returns an empty string when the
+// mission never produced one.
+type DemoTokenStore interface {
+	ReadDemoToken(ctx context.Context, missionID string) (string, error)
+}
+
+// Exporter assembles commission audit trails from the stores that own each record.
+type Exporter struct {
+	commissions CommissionStore
+	plans       PlanStore
+	approvals   ApprovalStore
+	events      protocol.EventStore
+	demoTokens  DemoTokenStore
+	now         func() time.Time
+}
+
+// NewExporter constructs an audit trail exporter.
+func NewExporter(
+	commissions CommissionStore,
+	plans PlanStore,
+	approvals ApprovalStore,
+	events protocol.EventStore,
+	demoTokens DemoTokenStore,
+) (*Exporter, error) {
+	if commissions == nil {
+		return nil, errors.New("commission store is required")
+	}
+	if plans == nil {
+		return nil, errors.New("plan store is required")
+	}
+	if approvals == nil {
+		return nil, errors.New("approval store is required")
+	}
+	if events == nil {
+		return nil, errors.New("protocol event store is required")
+	}
+	if demoTokens == nil {
+		return nil, errors.New("demo token store is required")
+	}
+	return &Exporter{
+		commissions: commissions,
+		plans:       plans,
+		approvals:   approvals,
+		events:      events,
+		demoTokens:  demoTokens,
+		now:         time.Now,
+	}, nil
+}
+
+// ExportAuditTrail returns the commission's PRD, plan result, approvals, per-mission
+// protocol events, and demo tokens as one JSON archive with secrets redacted.
+func (e *Exporter) ExportAuditTrail(ctx context.Context, commissionID string) ([]byte, error) {
+	if e == nil {
+		return nil, errors.New("audit exporter is nil")
+	}
+	commissionID = strings.TrimSpace(commissionID)
+	if commissionID == "" {
+		return nil, errors.New("commission id must not be empty")
+	}
+
+	loaded, err := e.commissions.LoadCommission(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("load commission %s: %w", commissionID, err)
+	}
+	plan, err := e.plans.LoadPlan(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("load plan for %s: %w", commissionID, err)
+	}
+	approvals, err := e.approvals.ListApprovals(ctx, commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("list approvals for %s: %w", commissionID, err)
+	}
+
+	archive := Archive{
+		Version:    ArchiveVersion,
+		ExportedAt: e.now().UTC(),
+		Commission: CommissionRecord{
+			ID:     commissionID,
+			Title:  loaded.Title,
+			Status: string(loaded.Status),
+			PRD:    telemetry.MaskSecrets(loaded.PRDContent),
+		},
+		Plan:      redactPlan(plan),
+		Approvals: make([]Approval, 0, len(approvals)),
+		Missions:  make([]MissionRecord, 0, len(plan.MissionList)),
+	}
+	for _, approval := range approvals {
+		approval.Feedback = telemetry.MaskSecrets(approval.Feedback)
+		archive.Approvals = append(archive.Approvals, approval)
+	}
+
+	for _, mission := range plan.MissionList {
+		record, err := e.missionRecord(ctx, mission)
+		if err != nil {
+			return nil, err
+		}
+		archive.Missions = append(archive.Missions, record)
+	}
+
+	out, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode audit archive: %w", err)
+	}
+	return out, nil
+}
+
+func (e *Exporter) missionRecord(ctx context.Context, mission commission.PlanMission) (MissionRecord, error) {
+	events, err := e.events.ListByMission(ctx, mission.ID)
+	if err != nil {
+		return MissionRecord{}, fmt.Errorf("list protocol events for mission %s: %w", mission.ID, err)
+	}
+	token, err := e.demoTokens.ReadDemoToken(ctx, mission.ID)
+	if err != nil {
+		return MissionRecord{}, fmt.Errorf("read demo token for mission %s: %w", mission.ID, err)
+	}
+
+	record := MissionRecord{
+		ID:        mission.ID,
+		Title:     mission.Title,
+		Events:    make([]protocol.ProtocolEvent, 0, len(events)),
+		DemoToken: telemetry.MaskSecrets(token),
+	}
+	for _, event := range events {
+		event.Payload = redactPayload(event.Payload)
+		record.Events = append(record.Events, event)
+	}
+	return record, nil
+}
+
+// redactPlan masks secrets in Ready Room message content, the only free text in a plan.
+func redactPlan(plan commission.PlanState) commission.PlanState {
+	if len(plan.ReadyRoomMessages) == 0 {
+		return plan
+	}
+	messages := make([]commission.PlanMessage, len(plan.ReadyRoomMessages))
+	for i, message := range plan.ReadyRoomMessages {
+		message.Content = telemetry.MaskSecrets(message.Content)
+		messages[i] = message
+	}
+	plan.ReadyRoomMessages = messages
+	return plan
+}
+
+// redactPayload masks secrets in a protocol event payload. Values under sensitive keys are
+// replaced outright; payloads that are not JSON are kept as a masked JSON string.
+func redactPayload(payload json.RawMessage) json.RawMessage {
+	if len(payload) == 0 {
+		return payload
+	}
+	var decoded any
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		masked, _ := json.Marshal(telemetry.MaskSecrets(string(payload)))
+		return masked
+	}
+	redacted, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return payload
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			if _, sensitive := sensitivePayloadKeys[normalizeKey(key)]; sensitive {
+				typed[key] = redactedValue
+				continue
+			}
+			typed[key] = redactValue(item)
+		}
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = redactValue(item)
+		}
+		return typed
+	case string:
+		return telemetry.MaskSecrets(typed)
+	default:
+		return value
+	}
+}
+
+func normalizeKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+}
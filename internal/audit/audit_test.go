@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/commission"
+	"github.com/ship-commander/sc3/internal/protocol"
+)
+
+func TestExportAuditTrailIncludesEverySectionForCompletedCommission(t *testing.T) {
+	t.Parallel()
+
+	events := protocol.NewInMemoryStore()
+	for _, event := range []protocol.ProtocolEvent{
+		{
+			ProtocolVersion: protocol.ProtocolVersion,
+			Type:            protocol.EventTypeAgentClaim,
+			MissionID:       "m-1",
+			Payload:         json.RawMessage(`{"claim":"done","token":"s3cr3t-value"}`),
+		},
+		{
+			ProtocolVersion: protocol.ProtocolVersion,
+			Type:            protocol.EventTypeReviewComplete,
+			MissionID:       "m-1",
+			Payload:         json.RawMessage(`{"verdict":"APPROVED","feedback":"use password=hunter2 locally"}`),
+		},
+	} {
+		if err := events.Append(context.Background(), event); err != nil {
+			t.Fatalf("append event: %v", err)
+		}
+	}
+
+	exporter, err := NewExporter(
+		fakeCommissionStore{commission: commission.Commission{
+			ID:         "comm-1",
+			Title:      "Fleet upgrade",
+			Status:     commission.StatusCompleted,
+			PRDContent: "# Fleet upgrade\nDeploy with api_key=abc123",
+		}},
+		fakePlanStore{plan: commission.PlanState{
+			MissionList:     []commission.PlanMission{{ID: "m-1", Title: "Schema"}},
+			WaveAssignments: []commission.PlanWave{{Index: 1, MissionIDs: []string{"m-1"}}},
+		}},
+		fakeApprovalStore{approvals: []Approval{{
+			Subject:   "manifest approval",
+			Decision:  "approved",
+			Actor:     "admiral",
+			DecidedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		}}},
+		events,
+		fakeDemoTokenStore{tokens: map[string]string{"m-1": "mission_id: m-1\nsecret: sk-abcdefghijklmnop"}},
+	)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+
+	raw, err := exporter.ExportAuditTrail(context.Background(), "comm-1")
+	if err != nil {
+		t.Fatalf("export audit trail: %v", err)
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &sections); err != nil {
+		t.Fatalf("decode archive: %v", err)
+	}
+	for _, section := range []string{"version", "exportedAt", "commission", "plan", "approvals", "missions"} {
+		if _, ok := sections[section]; !ok {
+			t.Fatalf("archive missing %q section:\n%s", section, raw)
+		}
+	}
+
+	var archive Archive
+	if err := json.Unmarshal(raw, &archive); err != nil {
+		t.Fatalf("decode archive: %v", err)
+	}
+	if archive.Commission.ID != "comm-1" || !strings.Contains(archive.Commission.PRD, "# Fleet upgrade") {
+		t.Fatalf("commission = %+v, want comm-1 with PRD", archive.Commission)
+	}
+	if len(archive.Plan.WaveAssignments) != 1 {
+		t.Fatalf("plan waves = %+v, want the persisted wave", archive.Plan.WaveAssignments)
+	}
+	if len(archive.Approvals) != 1 || archive.Approvals[0].Decision != "approved" {
+		t.Fatalf("approvals = %+v, want the manifest approval", archive.Approvals)
+	}
+	if len(archive.Missions) != 1 || len(archive.Missions[0].Events) != 2 || archive.Missions[0].DemoToken == "" {
+		t.Fatalf("missions = %+v, want m-1 with events and demo token", archive.Missions)
+	}
+
+	for _, secret := range []string{"abc123", "s3cr3t-value", "hunter2", "sk-abcdefghijklmnop"} {
+		if strings.Contains(string(raw), secret) {
+			t.Fatalf("archive leaked %q:\n%s", secret, raw)
+		}
+	}
+}
+
+type fakeCommissionStore struct {
+	commission commission.Commission
+}
+
+func (f fakeCommissionStore) LoadCommission(context.Context, string) (commission.Commission, error) {
+	return f.commission, nil
+}
+
+type fakePlanStore struct {
+	plan commission.PlanState
+}
+
+func (f fakePlanStore) LoadPlan(context.Context, string) (commission.PlanState, error) {
+	return f.plan, nil
+}
+
+type fakeApprovalStore struct {
+	approvals []Approval
+}
+
+func (f fakeApprovalStore) ListApprovals(context.Context, string) ([]Approval, error) {
+	return f.approvals, nil
+}
+
+type fakeDemoTokenStore struct {
+	tokens map[string]string
+}
+
+func (f fakeDemoTokenStore) ReadDemoToken(_ context.Context, missionID string) (string, error) {
+	return f.tokens[missionID], nil
+}
@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/commission"
+	"github.com/ship-commander/sc3/internal/demo"
+)
+
+// CommandRunner executes shell commands for the Beads-backed audit stores.
+type CommandRunner interface {
+	Run(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+type defaultCommandRunner struct{}
+
+func (defaultCommandRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("run %s %s: %w (%s)", name, strings.Join(args, " "), err, strings.TrimSpace(string(output)))
+	}
+	return output, nil
+}
+
+// BeadsStore reads the commission, persisted plan, and recorded approvals for an audit
+// export from the commission bead.
+type BeadsStore struct {
+	runner CommandRunner
+}
+
+// NewBeadsStore creates a Beads-backed audit store.
+func NewBeadsStore() (*BeadsStore, error) {
+	return NewBeadsStoreWithRunner(defaultCommandRunner{})
+}
+
+// NewBeadsStoreWithRunner creates a Beads-backed audit store with a custom runner.
+func NewBeadsStoreWithRunner(runner CommandRunner) (*BeadsStore, error) {
+	if runner == nil {
+		return nil, errors.New("runner must not be nil")
+	}
+	return &BeadsStore{runner: runner}, nil
+}
+
+type commissionBead struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Comments    []struct {
+		Text string `json:"text"`
+	} `json:"comments"`
+}
+
+// LoadCommission decodes the commission persisted in the bead description by
+// commission.Persist.
+func (s *BeadsStore) LoadCommission(ctx context.Context, commissionID string) (commission.Commission, error) {
+	bead, err := s.show(ctx, commissionID)
+	if err != nil {
+		return commission.Commission{}, err
+	}
+	var loaded commission.Commission
+	if description := strings.TrimSpace(bead.Description); description != "" {
+		if err := json.Unmarshal([]byte(description), &loaded); err != nil {
+			return commission.Commission{}, fmt.Errorf("parse commission %s description: %w", commissionID, err)
+		}
+	}
+	loaded.ID = bead.ID
+	if strings.TrimSpace(loaded.Title) == "" {
+		loaded.Title = bead.Title
+	}
+	return loaded, nil
+}
+
+// LoadPlan loads the mission manifest persisted in the commission bead notes.
+func (s *BeadsStore) LoadPlan(ctx context.Context, commissionID string) (commission.PlanState, error) {
+	if s == nil {
+		return commission.PlanState{}, errors.New("beads store is nil")
+	}
+	return commission.LoadPlanWithRunner(ctx, commissionID, s.runner)
+}
+
+// ListApprovals rebuilds every approval recorded by admiral.BeadsApprovalRecorder from its
+// append-only audit comments, ordered by decision time. The approval.* state keys only hold
+// the latest decision per subject, so they cannot replay a subject decided more than once.
+func (s *BeadsStore) ListApprovals(ctx context.Context, commissionID string) ([]Approval, error) {
+	bead, err := s.show(ctx, commissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]Approval, 0)
+	for _, comment := range bead.Comments {
+		approval, ok := parseApprovalComment(comment.Text)
+		if ok {
+			approvals = append(approvals, approval)
+		}
+	}
+	sort.SliceStable(approvals, func(i, j int) bool {
+		return approvals[i].DecidedAt.Before(approvals[j].DecidedAt)
+	})
+	return approvals, nil
+}
+
+func (s *BeadsStore) show(ctx context.Context, commissionID string) (commissionBead, error) {
+	if s == nil {
+		return commissionBead{}, errors.New("beads store is nil")
+	}
+	commissionID = strings.TrimSpace(commissionID)
+	if commissionID == "" {
+		return commissionBead{}, errors.New("commission id must not be empty")
+	}
+	out, err := s.runner.Run(ctx, "bd", "show", commissionID, "--json")
+	if err != nil {
+		return commissionBead{}, fmt.Errorf("show commission %s: %w", commissionID, err)
+	}
+	var beads []commissionBead
+	if err := json.Unmarshal(out, &beads); err != nil {
+		return commissionBead{}, fmt.Errorf("parse commission %s JSON: %w", commissionID, err)
+	}
+	if len(beads) == 0 {
+		return commissionBead{}, fmt.Errorf("commission %s not found", commissionID)
+	}
+	return beads[0], nil
+}
+
+// approvalCommentPattern matches the comment admiral.BeadsApprovalRecorder writes for each
+// decision: "<subject>: <decision> by <actor> at <RFC3339 time>[ (feedback: <text>)]".
+var approvalCommentPattern = regexp.MustCompile(
+	`(?s)^(manifest approval|wave \d+ review|mission \S+ escalation): (\S+) by (.+?) at (\S+)(?: \(feedback: (.*)\))?$`,
+)
+
+func parseApprovalComment(text string) (Approval, bool) {
+	match := approvalCommentPattern.FindStringSubmatch(strings.TrimSpace(text))
+	if match == nil {
+		return Approval{}, false
+	}
+	decidedAt, err := time.Parse(time.RFC3339Nano, match[4])
+	if err != nil {
+		return Approval{}, false
+	}
+	return Approval{
+		Subject:   match[1],
+		Decision:  match[2],
+		Actor:     match[3],
+		DecidedAt: decidedAt.UTC(),
+		Feedback:  match[5],
+	}, true
+}
+
+// FileDemoTokenStore reads mission demo tokens from a checkout, such as the repository
+// root once mission branches have merged.
+type FileDemoTokenStore struct {
+	root      string
+	templates []string
+}
+
+// NewFileDemoTokenStore creates a DemoTokenStore rooted at root. Templates follow
+// demo.ResolveTokenPath and default to demo.DefaultTokenPathTemplate.
+func NewFileDemoTokenStore(root string, templates ...string) (*FileDemoTokenStore, error) {
+	if strings.TrimSpace(root) == "" {
+		return nil, errors.New("demo token root must not be empty")
+	}
+	return &FileDemoTokenStore{root: root, templates: templates}, nil
+}
+
+// ReadDemoToken returns the mission's demo token, or an empty string when the mission
+// has none.
+func (s *FileDemoTokenStore) ReadDemoToken(_ context.Context, missionID string) (string, error) {
+	tokenPath, err := demo.ResolveTokenPath(s.root, missionID, s.templates)
+	if err != nil {
+		return "", err
+	}
+	// #nosec G304 -- tokenPath is constrained to the token root and deterministic mission filename.
+	content, err := os.ReadFile(tokenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read demo token %s: %w", tokenPath, err)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,161 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/admiral"
+	"github.com/ship-commander/sc3/internal/commission"
+)
+
+func TestBeadsStoreReadsEveryApprovalRecordedByAdmiral(t *testing.T) {
+	t.Parallel()
+
+	bead := &fakeBead{state: make(map[string]string)}
+	recorder, err := admiral.NewBeadsApprovalRecorder(bead)
+	if err != nil {
+		t.Fatalf("new approval recorder: %v", err)
+	}
+	// Unrelated commission comments are not approvals.
+	if err := bead.AddComment("comm-1", "question q-1 asked by captain: Which database?"); err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+	manifestAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, record := range []admiral.ApprovalRecord{
+		{
+			Request:    admiral.ApprovalRequest{CommissionID: "comm-1", WaveReview: &admiral.WaveReview{WaveIndex: 1}},
+			Response:   admiral.ApprovalResponse{Decision: admiral.ApprovalDecisionFeedback, FeedbackText: "tighten the schema"},
+			AnsweredAt: manifestAt.Add(time.Hour),
+		},
+		{
+			Request:    admiral.ApprovalRequest{CommissionID: "comm-1"},
+			Response:   admiral.ApprovalResponse{Decision: admiral.ApprovalDecisionApproved, Actor: "jordan"},
+			AnsweredAt: manifestAt,
+		},
+		{
+			Request:    admiral.ApprovalRequest{CommissionID: "comm-1", WaveReview: &admiral.WaveReview{WaveIndex: 1}},
+			Response:   admiral.ApprovalResponse{Decision: admiral.ApprovalDecisionApproved},
+			AnsweredAt: manifestAt.Add(2 * time.Hour),
+		},
+	} {
+		if err := recorder.RecordApproval(context.Background(), record); err != nil {
+			t.Fatalf("record approval: %v", err)
+		}
+	}
+
+	store, err := NewBeadsStoreWithRunner(bead)
+	if err != nil {
+		t.Fatalf("new beads store: %v", err)
+	}
+	approvals, err := store.ListApprovals(context.Background(), "comm-1")
+	if err != nil {
+		t.Fatalf("list approvals: %v", err)
+	}
+	want := []Approval{
+		{Subject: "manifest approval", Decision: "Approved", Actor: "jordan", DecidedAt: manifestAt},
+		{Subject: "wave 1 review", Decision: "Feedback", Actor: "admiral", DecidedAt: manifestAt.Add(time.Hour), Feedback: "tighten the schema"},
+		{Subject: "wave 1 review", Decision: "Approved", Actor: "admiral", DecidedAt: manifestAt.Add(2 * time.Hour)},
+	}
+	if !reflect.DeepEqual(approvals, want) {
+		t.Fatalf("approvals = %+v, want %+v", approvals, want)
+	}
+}
+
+func TestBeadsStoreLoadsPersistedCommission(t *testing.T) {
+	t.Parallel()
+
+	bead := &fakeBead{state: make(map[string]string)}
+	if _, err := commission.PersistWithRunner(context.Background(), &commission.Commission{
+		Title:      "Fleet upgrade",
+		Status:     commission.StatusExecuting,
+		PRDContent: "# Fleet upgrade",
+	}, bead); err != nil {
+		t.Fatalf("persist commission: %v", err)
+	}
+
+	store, err := NewBeadsStoreWithRunner(bead)
+	if err != nil {
+		t.Fatalf("new beads store: %v", err)
+	}
+	loaded, err := store.LoadCommission(context.Background(), "comm-1")
+	if err != nil {
+		t.Fatalf("load commission: %v", err)
+	}
+	if loaded.ID != "comm-1" || loaded.Title != "Fleet upgrade" || loaded.Status != commission.StatusExecuting || loaded.PRDContent != "# Fleet upgrade" {
+		t.Fatalf("loaded commission = %+v", loaded)
+	}
+}
+
+func TestFileDemoTokenStoreReadsMergedTokensAndToleratesMissingOnes(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "demo"), 0o750); err != nil {
+		t.Fatalf("mkdir demo: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "demo", "MISSION-m-1.md"), []byte("mission_id: m-1\n"), 0o600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+
+	store, err := NewFileDemoTokenStore(root)
+	if err != nil {
+		t.Fatalf("new demo token store: %v", err)
+	}
+	token, err := store.ReadDemoToken(context.Background(), "m-1")
+	if err != nil || token != "mission_id: m-1\n" {
+		t.Fatalf("read m-1 token = %q, %v", token, err)
+	}
+	token, err = store.ReadDemoToken(context.Background(), "m-2")
+	if err != nil || token != "" {
+		t.Fatalf("read missing token = %q, %v; want empty and no error", token, err)
+	}
+}
+
+// fakeBead stands in for one commission bead, answering both the Beads client calls made
+// by recorders and the `bd` commands run by BeadsStore.
+type fakeBead struct {
+	description string
+	state       map[string]string
+	comments    []string
+}
+
+func (f *fakeBead) SetState(_, key, value string) error {
+	f.state[key] = value
+	return nil
+}
+
+func (f *fakeBead) AddComment(_, comment string) error {
+	f.comments = append(f.comments, comment)
+	return nil
+}
+
+func (f *fakeBead) Run(_ context.Context, _ string, args ...string) ([]byte, error) {
+	switch args[0] {
+	case "create":
+		for i, arg := range args {
+			if arg == "--description" {
+				f.description = args[i+1]
+			}
+		}
+		return []byte("comm-1\n"), nil
+	case "show":
+		comments := make([]map[string]string, 0, len(f.comments))
+		for _, comment := range f.comments {
+			comments = append(comments, map[string]string{"text": comment})
+		}
+		return json.Marshal([]map[string]any{{
+			"id":          args[1],
+			"title":       "bead title",
+			"description": f.description,
+			"state":       f.state,
+			"comments":    comments,
+		}})
+	default:
+		return nil, os.ErrInvalid
+	}
+}
@@ -10,18 +10,36 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	defaultCommand = "bd"
-	defaultTimeout = 30 * time.Second
+	defaultCommand          = "bd"
+	defaultTimeout          = 30 * time.Second
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = 100 * time.Millisecond
 )
 
+// transientStderrPatterns are lower-cased bd stderr fragments that indicate lock
+// contention worth retrying rather than a real command failure.
+var transientStderrPatterns = []string{
+	"database is locked",
+	"database table is locked",
+	"sqlite_busy",
+	"resource temporarily unavailable",
+}
+
 type commandRunner interface {
 	Run(ctx context.Context, dir string, name string, args ...string) ([]byte, []byte, error)
 }
 
+// inputRunner is implemented by runners that can feed stdin to the command, which
+// `bd create --batch` needs for its JSON payload.
+type inputRunner interface {
+	RunInput(ctx context.Context, dir string, name string, stdin []byte, args ...string) ([]byte, []byte, error)
+}
+
 type defaultCommandRunner struct{}
 
 func (defaultCommandRunner) Run(ctx context.Context, dir string, name string, args ...string) ([]byte, []byte, error) {
@@ -37,20 +55,56 @@ func (defaultCommandRunner) Run(ctx context.Context, dir string, name string, ar
 	return stdout.Bytes(), stderr.Bytes(), err
 }
 
+func (defaultCommandRunner) RunInput(ctx context.Context, dir string, name string, stdin []byte, args ...string) ([]byte, []byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+// retryConfig bounds retries of bd invocations that fail with transient lock contention.
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// Option customizes a Client.
+type Option func(*Client)
+
+// WithRetry retries bd invocations whose stderr reports transient lock contention up to
+// maxAttempts times in total, waiting baseDelay before the first retry and doubling after
+// each. A maxAttempts below 2 disables retries.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) {
+		c.retry = retryConfig{maxAttempts: maxAttempts, baseDelay: baseDelay}
+	}
+}
+
 // Client wraps the `bd` CLI and returns typed results.
 type Client struct {
 	workDir string
 	command string
 	timeout time.Duration
 	runner  commandRunner
+	retry   retryConfig
+	// noBatch is set once bd rejects `create --batch`, so later batches go straight to
+	// sequential creates.
+	noBatch atomic.Bool
 }
 
 // NewClient creates a Beads client rooted at workDir and validates bd availability.
-func NewClient(workDir string) (*Client, error) {
-	return newClient(workDir, defaultCommand, defaultTimeout, defaultCommandRunner{})
+func NewClient(workDir string, opts ...Option) (*Client, error) {
+	return newClient(workDir, defaultCommand, defaultTimeout, defaultCommandRunner{}, opts...)
 }
 
-func newClient(workDir, command string, timeout time.Duration, runner commandRunner) (*Client, error) {
+func newClient(workDir, command string, timeout time.Duration, runner commandRunner, opts ...Option) (*Client, error) {
 	if strings.TrimSpace(workDir) == "" {
 		cwd, err := os.Getwd()
 		if err != nil {
@@ -73,6 +127,15 @@ func newClient(workDir, command string, timeout time.Duration, runner commandRun
 		command: command,
 		timeout: timeout,
 		runner:  runner,
+		retry:   retryConfig{maxAttempts: defaultRetryMaxAttempts, baseDelay: defaultRetryBaseDelay},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(client)
+		}
+	}
+	if client.retry.baseDelay < 0 {
+		return nil, errors.New("retry base delay must not be negative")
 	}
 
 	if err := client.checkCLI(); err != nil {
@@ -159,6 +222,141 @@ func (c *Client) Create(opts CreateOpts) (string, error) {
 	return created.ID, nil
 }
 
+// batchCreateItem is one entry of the `bd create --batch` JSON payload.
+type batchCreateItem struct {
+	Title       string   `json:"title"`
+	IssueType   string   `json:"issue_type"`
+	Description string   `json:"description,omitempty"`
+	Parent      string   `json:"parent,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+}
+
+// CreateBatch creates one issue per entry in opts and returns their IDs in input order.
+// It sends a single `bd create --batch` with a JSON payload on stdin when bd supports it
+// and otherwise falls back to sequential Create calls. If a sequential create fails, the
+// IDs created so far are returned with an error naming the failing index.
+func (c *Client) CreateBatch(opts []CreateOpts) ([]string, error) {
+	if len(opts) == 0 {
+		return []string{}, nil
+	}
+
+	items := make([]batchCreateItem, 0, len(opts))
+	for i, opt := range opts {
+		title := strings.TrimSpace(opt.Title)
+		if title == "" {
+			return []string{}, fmt.Errorf("create batch item %d: create title must not be empty", i)
+		}
+		item := batchCreateItem{
+			Title:       title,
+			IssueType:   strings.TrimSpace(opt.Type),
+			Description: opt.Description,
+			Labels:      opt.Labels,
+			Priority:    strings.TrimSpace(opt.Priority),
+		}
+		if item.IssueType == "" {
+			item.IssueType = "task"
+		}
+		if opt.Parent != nil {
+			item.Parent = strings.TrimSpace(*opt.Parent)
+		}
+		items = append(items, item)
+	}
+
+	if ids, ok, err := c.createBatchCommand(items); ok {
+		return ids, err
+	}
+
+	ids := make([]string, 0, len(opts))
+	for i, opt := range opts {
+		id, err := c.Create(opt)
+		if err != nil {
+			return ids, fmt.Errorf("create batch item %d: %w", i, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// createBatchCommand runs `bd create --batch`. It reports ok=false when the runner cannot
+// pass stdin or bd does not support batch creation, so the caller falls back.
+func (c *Client) createBatchCommand(items []batchCreateItem) ([]string, bool, error) {
+	if c.noBatch.Load() {
+		return nil, false, nil
+	}
+	if _, ok := c.runner.(inputRunner); !ok {
+		return nil, false, nil
+	}
+
+	payload, err := json.Marshal(items)
+	if err != nil {
+		return []string{}, true, fmt.Errorf("encode create batch payload: %w", err)
+	}
+	out, stderr, err := c.runWithInput(payload, "create", "--batch")
+	if err != nil {
+		if isUnsupportedBatchStderr(stderr) {
+			c.noBatch.Store(true)
+			return nil, false, nil
+		}
+		return []string{}, true, fmt.Errorf("create bead batch: %w", err)
+	}
+
+	var created []Bead
+	if err := decodeJSON(out, &created); err != nil {
+		return []string{}, true, fmt.Errorf("parse create batch output JSON: %w", err)
+	}
+	if len(created) != len(items) {
+		return []string{}, true, fmt.Errorf("create batch output has %d issues, want %d", len(created), len(items))
+	}
+	ids := make([]string, 0, len(created))
+	for i, bead := range created {
+		if strings.TrimSpace(bead.ID) == "" {
+			return ids, true, fmt.Errorf("create batch item %d: create output missing id", i)
+		}
+		ids = append(ids, bead.ID)
+	}
+	return ids, true, nil
+}
+
+// isUnsupportedBatchStderr reports whether bd rejected --batch as an unknown flag.
+func isUnsupportedBatchStderr(stderr []byte) bool {
+	lower := strings.ToLower(string(stderr))
+	return strings.Contains(lower, "unknown flag") && strings.Contains(lower, "batch")
+}
+
+// Update edits an existing issue, sending only the non-empty fields in opts.
+func (c *Client) Update(id string, opts UpdateOpts) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("issue id must not be empty")
+	}
+
+	args := []string{"update", id}
+	if strings.TrimSpace(opts.Title) != "" {
+		args = append(args, "--title", strings.TrimSpace(opts.Title))
+	}
+	if strings.TrimSpace(opts.Description) != "" {
+		args = append(args, "--description", opts.Description)
+	}
+	if strings.TrimSpace(opts.Priority) != "" {
+		args = append(args, "--priority", strings.TrimSpace(opts.Priority))
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "--labels", strings.Join(opts.Labels, ","))
+	}
+	if len(args) == 2 {
+		return errors.New("update requires at least one field")
+	}
+
+	out, err := c.run(args...)
+	if err != nil {
+		return fmt.Errorf("update bead %q: %w", id, err)
+	}
+	if _, err := decodeSingleBead(out); err != nil {
+		return fmt.Errorf("parse update output JSON: %w", err)
+	}
+	return nil
+}
+
 // Show returns one bead by ID.
 func (c *Client) Show(id string) (*Bead, error) {
 	if strings.TrimSpace(id) == "" {
@@ -230,6 +428,27 @@ func (c *Client) SetState(id, key, value string) error {
 	return nil
 }
 
+// Close closes an issue, recording reason when one is given.
+func (c *Client) Close(id, reason string) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("issue id must not be empty")
+	}
+
+	args := []string{"close", id}
+	if strings.TrimSpace(reason) != "" {
+		args = append(args, "--reason", strings.TrimSpace(reason))
+	}
+
+	out, err := c.run(args...)
+	if err != nil {
+		return fmt.Errorf("close bead %q: %w", id, err)
+	}
+	if _, err := decodeSingleBead(out); err != nil {
+		return fmt.Errorf("parse close output JSON: %w", err)
+	}
+	return nil
+}
+
 // AddDep adds a dependency edge `childID -> parentID`.
 func (c *Client) AddDep(childID, parentID string) error {
 	if strings.TrimSpace(childID) == "" {
@@ -323,17 +542,43 @@ func (c *Client) AgentHeartbeat(id string) error {
 }
 
 func (c *Client) run(args ...string) ([]byte, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
-	defer cancel()
+	stdout, _, err := c.runWithInput(nil, args...)
+	return stdout, err
+}
 
+// runWithInput invokes bd with --json, feeding stdin when it is non-nil, and retries
+// transient lock contention. The last attempt's stderr is returned for classification.
+func (c *Client) runWithInput(stdin []byte, args ...string) ([]byte, []byte, error) {
 	commandArgs := append([]string{}, args...)
 	if !hasJSONFlag(commandArgs) {
 		commandArgs = append(commandArgs, "--json")
 	}
 
-	stdout, stderr, err := c.runner.Run(ctx, c.workDir, c.command, commandArgs...)
+	delay := c.retry.baseDelay
+	for attempt := 1; ; attempt++ {
+		stdout, stderr, err := c.runOnce(stdin, commandArgs)
+		if err == nil || attempt >= c.retry.maxAttempts || !isTransientStderr(stderr) {
+			return stdout, stderr, err
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// runOnce invokes bd once under the client timeout.
+func (c *Client) runOnce(stdin []byte, commandArgs []string) ([]byte, []byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var stdout, stderr []byte
+	var err error
+	if runner, ok := c.runner.(inputRunner); ok && stdin != nil {
+		stdout, stderr, err = runner.RunInput(ctx, c.workDir, c.command, stdin, commandArgs...)
+	} else {
+		stdout, stderr, err = c.runner.Run(ctx, c.workDir, c.command, commandArgs...)
+	}
 	if err != nil {
-		return nil, fmt.Errorf(
+		return nil, stderr, fmt.Errorf(
 			"run %s %s: %w (stderr: %s)",
 			c.command,
 			strings.Join(commandArgs, " "),
@@ -342,7 +587,17 @@ func (c *Client) run(args ...string) ([]byte, error) {
 		)
 	}
 
-	return bytes.TrimSpace(stdout), nil
+	return bytes.TrimSpace(stdout), stderr, nil
+}
+
+func isTransientStderr(stderr []byte) bool {
+	lower := strings.ToLower(string(stderr))
+	for _, pattern := range transientStderrPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
 }
 
 func hasJSONFlag(args []string) bool {
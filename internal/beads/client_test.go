@@ -2,11 +2,13 @@ package beads
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -45,6 +47,17 @@ func (f *fakeCommandRunner) Run(_ context.Context, dir string, name string, args
 	return next.stdout, next.stderr, next.err
 }
 
+// fakeInputRunner also accepts stdin, enabling the `bd create --batch` path.
+type fakeInputRunner struct {
+	fakeCommandRunner
+	stdin [][]byte
+}
+
+func (f *fakeInputRunner) RunInput(ctx context.Context, dir string, name string, stdin []byte, args ...string) ([]byte, []byte, error) {
+	f.stdin = append(f.stdin, append([]byte(nil), stdin...))
+	return f.Run(ctx, dir, name, args...)
+}
+
 func TestNewClientChecksCLIAvailability(t *testing.T) {
 	t.Parallel()
 
@@ -201,6 +214,286 @@ func TestCreateReturnsIDAndBuildsExpectedArgs(t *testing.T) {
 	}
 }
 
+func TestUpdateBuildsArgsFromNonEmptyFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     UpdateOpts
+		wantArgs []string
+	}{
+		{
+			name: "all fields",
+			opts: UpdateOpts{
+				Title:       "revised",
+				Description: "new desc",
+				Priority:    "2",
+				Labels:      []string{"phase:2", "type:mission"},
+			},
+			wantArgs: []string{
+				"update", "ship-commander-3-1",
+				"--title", "revised",
+				"--description", "new desc",
+				"--priority", "2",
+				"--labels", "phase:2,type:mission",
+				"--json",
+			},
+		},
+		{
+			name:     "title only",
+			opts:     UpdateOpts{Title: " revised "},
+			wantArgs: []string{"update", "ship-commander-3-1", "--title", "revised", "--json"},
+		},
+		{
+			name:     "priority and labels",
+			opts:     UpdateOpts{Priority: "0", Labels: []string{"urgent"}},
+			wantArgs: []string{"update", "ship-commander-3-1", "--priority", "0", "--labels", "urgent", "--json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &fakeCommandRunner{
+				results: []fakeResult{
+					{stdout: []byte(`{"version":"1.0.0"}`)},
+					{stdout: []byte(`[{"id":"ship-commander-3-1","title":"revised"}]`)},
+				},
+			}
+			client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			if err := client.Update("ship-commander-3-1", tt.opts); err != nil {
+				t.Fatalf("update: %v", err)
+			}
+			if got := runner.calls[1].args; !reflect.DeepEqual(got, tt.wantArgs) {
+				t.Fatalf("update args = %v, want %v", got, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestUpdateRejectsInvalidInputAndWrapsFailures(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stderr: []byte("no such issue"), err: errors.New("exit status 1")},
+		},
+	}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Update(" ", UpdateOpts{Title: "x"}); err == nil {
+		t.Fatal("expected error for empty id")
+	}
+	if err := client.Update("ship-commander-3-1", UpdateOpts{}); err == nil {
+		t.Fatal("expected error when no fields are set")
+	}
+
+	err = client.Update("ship-commander-3-404", UpdateOpts{Title: "x"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), `update bead "ship-commander-3-404"`) ||
+		!strings.Contains(err.Error(), "stderr: no such issue") {
+		t.Fatalf("error = %v, want update and stderr context", err)
+	}
+}
+
+func TestCreateBatchSendsOnePayloadAndPreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeInputRunner{fakeCommandRunner: fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stdout: []byte(`[{"id":"ship-commander-3-7"},{"id":"ship-commander-3-8"}]`)},
+		},
+	}}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	parent := "ship-commander-3-1"
+	ids, err := client.CreateBatch([]CreateOpts{
+		{Title: "Mission A", Parent: &parent, Labels: []string{"type:mission"}},
+		{Title: "Mission B", Type: "bug", Priority: "1"},
+	})
+	if err != nil {
+		t.Fatalf("create batch: %v", err)
+	}
+	if want := []string{"ship-commander-3-7", "ship-commander-3-8"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	if len(runner.calls) != 2 {
+		t.Fatalf("calls = %d, want version check plus one batch create", len(runner.calls))
+	}
+	if want := []string{"create", "--batch", "--json"}; !reflect.DeepEqual(runner.calls[1].args, want) {
+		t.Fatalf("batch args = %v, want %v", runner.calls[1].args, want)
+	}
+
+	var payload []batchCreateItem
+	if err := json.Unmarshal(runner.stdin[0], &payload); err != nil {
+		t.Fatalf("decode batch payload: %v", err)
+	}
+	wantPayload := []batchCreateItem{
+		{Title: "Mission A", IssueType: "task", Parent: parent, Labels: []string{"type:mission"}},
+		{Title: "Mission B", IssueType: "bug", Priority: "1"},
+	}
+	if !reflect.DeepEqual(payload, wantPayload) {
+		t.Fatalf("batch payload = %+v, want %+v", payload, wantPayload)
+	}
+}
+
+func TestCreateBatchFallsBackToSequentialCreates(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeInputRunner{fakeCommandRunner: fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stderr: []byte("Error: unknown flag: --batch"), err: errors.New("exit status 1")},
+			{stdout: []byte(`{"id":"ship-commander-3-7"}`)},
+			{stdout: []byte(`{"id":"ship-commander-3-8"}`)},
+			{stdout: []byte(`{"id":"ship-commander-3-9"}`)},
+		},
+	}}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ids, err := client.CreateBatch([]CreateOpts{{Title: "Mission A"}, {Title: "Mission B"}})
+	if err != nil {
+		t.Fatalf("create batch: %v", err)
+	}
+	if want := []string{"ship-commander-3-7", "ship-commander-3-8"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	if got := runner.calls[2].args; !reflect.DeepEqual(got, []string{"create", "--type", "task", "--title", "Mission A", "--json"}) {
+		t.Fatalf("first sequential create args = %v", got)
+	}
+
+	if _, err := client.CreateBatch([]CreateOpts{{Title: "Mission C"}}); err != nil {
+		t.Fatalf("second create batch: %v", err)
+	}
+	if len(runner.stdin) != 1 {
+		t.Fatalf("batch attempts = %d, want 1 after bd rejected --batch", len(runner.stdin))
+	}
+}
+
+func TestCreateBatchReturnsCreatedIDsOnMidBatchFailure(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stdout: []byte(`{"id":"ship-commander-3-7"}`)},
+			{stderr: []byte("parent not found"), err: errors.New("exit status 1")},
+		},
+	}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ids, err := client.CreateBatch([]CreateOpts{{Title: "Mission A"}, {Title: "Mission B"}, {Title: "Mission C"}})
+	if err == nil {
+		t.Fatal("expected create batch error")
+	}
+	if want := []string{"ship-commander-3-7"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for _, want := range []string{"create batch item 1", "parent not found"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("error = %q, want %q", err, want)
+		}
+	}
+	if len(runner.calls) != 3 {
+		t.Fatalf("calls = %d, want creates to stop at the failing item", len(runner.calls))
+	}
+}
+
+func TestCloseBuildsArgsWithOptionalReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		reason   string
+		wantArgs []string
+	}{
+		{
+			name:     "with reason",
+			reason:   "mission completed",
+			wantArgs: []string{"close", "ship-commander-3-1", "--reason", "mission completed", "--json"},
+		},
+		{
+			name:     "without reason",
+			reason:   "  ",
+			wantArgs: []string{"close", "ship-commander-3-1", "--json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &fakeCommandRunner{
+				results: []fakeResult{
+					{stdout: []byte(`{"version":"1.0.0"}`)},
+					{stdout: []byte(`[{"id":"ship-commander-3-1","status":"closed"}]`)},
+				},
+			}
+			client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			if err := client.Close("ship-commander-3-1", tt.reason); err != nil {
+				t.Fatalf("close: %v", err)
+			}
+			if got := runner.calls[1].args; !reflect.DeepEqual(got, tt.wantArgs) {
+				t.Fatalf("close args = %v, want %v", got, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCloseIncludesCommandContextOnFailure(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stderr: []byte("issue already closed"), err: errors.New("exit status 1")},
+		},
+	}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Close("ship-commander-3-1", "abandoned")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), `close bead "ship-commander-3-1"`) {
+		t.Fatalf("error = %v, want close context", err)
+	}
+	if !strings.Contains(err.Error(), "run sh close ship-commander-3-1 --reason abandoned --json") {
+		t.Fatalf("error = %v, want command/args context", err)
+	}
+	if !strings.Contains(err.Error(), "stderr: issue already closed") {
+		t.Fatalf("error = %v, want stderr context", err)
+	}
+}
+
 func TestShowParsesArrayOutput(t *testing.T) {
 	t.Parallel()
 
@@ -653,6 +946,83 @@ func TestRunAppendsJSONFlagOnce(t *testing.T) {
 	}
 }
 
+func TestRunRetriesTransientLockErrors(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeCommandRunner{
+		results: []fakeResult{
+			{stdout: []byte(`{"version":"1.0.0"}`)},
+			{stderr: []byte("Error: database is locked"), err: errors.New("exit status 1")},
+			{stdout: []byte(`{"status":"ok"}`)},
+		},
+	}
+	client, err := newClient(t.TempDir(), "sh", time.Second, runner, WithRetry(3, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.SetState("ship-commander-3-1", "phase", "green"); err != nil {
+		t.Fatalf("set state: %v", err)
+	}
+	if len(runner.calls) != 3 {
+		t.Fatalf("calls = %d, want version check plus two set-state attempts", len(runner.calls))
+	}
+	if !reflect.DeepEqual(runner.calls[1].args, runner.calls[2].args) {
+		t.Fatalf("retry args = %v, want %v", runner.calls[2].args, runner.calls[1].args)
+	}
+}
+
+func TestRunDoesNotRetryPermanentOrExhaustedErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		results   []fakeResult
+		wantCalls int
+		wantErr   string
+	}{
+		{
+			name: "permanent error fails immediately",
+			results: []fakeResult{
+				{stderr: []byte("Error: issue not found"), err: errors.New("exit status 1")},
+			},
+			wantCalls: 1,
+			wantErr:   "stderr: Error: issue not found",
+		},
+		{
+			name: "transient error stops at max attempts",
+			results: []fakeResult{
+				{stderr: []byte("database is locked"), err: errors.New("exit status 1")},
+				{stderr: []byte("database is locked"), err: errors.New("exit status 1")},
+			},
+			wantCalls: 2,
+			wantErr:   "stderr: database is locked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &fakeCommandRunner{
+				results: append([]fakeResult{{stdout: []byte(`{"version":"1.0.0"}`)}}, tt.results...),
+			}
+			client, err := newClient(t.TempDir(), "sh", time.Second, runner, WithRetry(2, time.Millisecond))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			err = client.SetState("ship-commander-3-1", "phase", "green")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+			if got := len(runner.calls) - 1; got != tt.wantCalls {
+				t.Fatalf("set-state attempts = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
 func TestListRejectsInvalidJSON(t *testing.T) {
 	t.Parallel()
 
@@ -51,6 +51,14 @@ type CreateOpts struct {
 	Priority    string
 }
 
+// UpdateOpts controls issue edits via `bd update`; empty fields are left unchanged.
+type UpdateOpts struct {
+	Title       string
+	Description string
+	Priority    string
+	Labels      []string
+}
+
 // ListOpts controls issue listing filters via `bd list`.
 type ListOpts struct {
 	Type   string
@@ -1,21 +1,37 @@
 package commander
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/ship-commander/sc3/internal/admiral"
+	"github.com/ship-commander/sc3/internal/config"
+	"github.com/ship-commander/sc3/internal/demo"
+	"github.com/ship-commander/sc3/internal/harness"
 	"github.com/ship-commander/sc3/internal/protocol"
 	"github.com/ship-commander/sc3/internal/telemetry"
 	"github.com/ship-commander/sc3/internal/telemetry/invariants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -25,8 +41,17 @@ const (
 	EventMissionHalted = "MISSION_HALTED"
 	// EventWaveFeedbackRecorded is emitted when Admiral feedback is captured at a wave checkpoint.
 	EventWaveFeedbackRecorded = "WAVE_FEEDBACK_RECORDED"
-	// EventCommissionHalted is emitted when Admiral halts execution during wave review.
+	// EventCommissionHalted is emitted when Admiral halts execution during wave review
+	// or the commission kill switch is engaged.
 	EventCommissionHalted = "COMMISSION_HALTED"
+	// EventMissionSkipped is emitted when a mission is not dispatched because it already completed
+	// or, with ContinueWaveOnMissionHalt, because a mission it depends on halted.
+	EventMissionSkipped = "MISSION_SKIPPED"
+	// EventMissionWaiting is emitted once when a wave mission is first observed blocked on dependencies.
+	EventMissionWaiting = "MISSION_WAITING"
+	// EventMissionProgress is emitted when a streaming harness reports intermediate progress.
+	// It does not notify the TUI, which polls WaveProgress instead of redrawing per update.
+	EventMissionProgress = "MISSION_PROGRESS"
 	// MissionClassificationStandardOps routes mission execution through the standard implementation fast path.
 	MissionClassificationStandardOps = "STANDARD_OPS"
 	// DefaultMaxRevisions is the deterministic default revision ceiling before halting.
@@ -35,6 +60,22 @@ const (
 	defaultReviewPollInterval = 200 * time.Millisecond
 	// defaultReviewTimeout bounds reviewer verdict waiting for deterministic mission completion.
 	defaultReviewTimeout = 5 * time.Minute
+	// defaultEventLogSize is the number of recent events retained in memory for snapshots.
+	defaultEventLogSize = 256
+	// defaultGateEvidenceBudget bounds gate evidence bytes forwarded to reviewer prompts.
+	defaultGateEvidenceBudget = 16 * 1024
+	// defaultDemoTokenRetries bounds re-validation of demo tokens after transient errors.
+	defaultDemoTokenRetries = 2
+	// defaultDemoTokenRetryBackoff is the pause between transient demo token validation attempts.
+	defaultDemoTokenRetryBackoff = 200 * time.Millisecond
+	// defaultDispatchRetryBaseDelay is the first backoff between retriable implementer dispatches.
+	defaultDispatchRetryBaseDelay = 500 * time.Millisecond
+	// defaultReadyPollBaseDelay is the first wait after ReadyMissionIDs reports nothing ready.
+	defaultReadyPollBaseDelay = 200 * time.Millisecond
+	// defaultReadyPollMaxDelay caps the wait between empty ReadyMissionIDs polls.
+	defaultReadyPollMaxDelay = 10 * time.Second
+	// defaultRevisionDiffBudget bounds revision diff history bytes forwarded to reviewer prompts.
+	defaultRevisionDiffBudget = 16 * 1024
 )
 
 var (
@@ -42,8 +83,75 @@ var (
 	ErrApprovalFeedback = errors.New("admiral requested planning feedback")
 	// ErrApprovalShelved indicates execution was paused because Admiral shelved the manifest.
 	ErrApprovalShelved = errors.New("admiral shelved mission manifest")
+	// ErrDemoTokenTransient marks demo token validation failures worth retrying, such as network mount hiccups.
+	ErrDemoTokenTransient = errors.New("transient demo token validation error")
+	// ErrCommissionHalted indicates execution stopped because the commission kill switch was engaged.
+	ErrCommissionHalted = errors.New("commission kill switch engaged")
+	// ErrMissionTimeout indicates a mission exceeded CommanderConfig.MissionTimeout.
+	ErrMissionTimeout = errors.New("mission timeout exceeded")
+	// ErrMaxExecutionDuration indicates Execute ran past CommanderConfig.MaxExecutionDuration.
+	ErrMaxExecutionDuration = errors.New("maximum execution duration exceeded")
+	// ErrCriticalMissionHalted indicates a CriticalPath mission halted, aborting the commission.
+	ErrCriticalMissionHalted = errors.New("critical-path mission halted")
 )
 
+// RetriableError marks a Harness dispatch failure as transient so the commander retries it
+// under CommanderConfig.DispatchRetry. Harness drivers, which cannot import this package,
+// mark transient failures with harness.ErrTransient instead.
+type RetriableError struct {
+	Err error
+}
+
+func (e *RetriableError) Error() string {
+	if e == nil || e.Err == nil {
+		return "retriable dispatch error"
+	}
+	return e.Err.Error()
+}
+
+func (e *RetriableError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// IsRetriable reports whether err is or wraps a RetriableError or harness.ErrTransient.
+func IsRetriable(err error) bool {
+	var retriable *RetriableError
+	return errors.As(err, &retriable) || errors.Is(err, harness.ErrTransient)
+}
+
+// DispatchRetry configures retries of implementer dispatches that fail with a retriable error
+// (see IsRetriable).
+type DispatchRetry struct {
+	// MaxAttempts is the total number of dispatch attempts; values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry and doubles for each later retry.
+	BaseDelay time.Duration
+}
+
+// ReadyPollBackoff configures how a wave waits when none of its remaining missions are ready,
+// for example while Beads resolves external dependencies.
+type ReadyPollBackoff struct {
+	// MaxPolls is how many consecutive empty polls are retried before the wave fails as stuck.
+	// Zero fails on the first empty poll; a negative value polls until the context ends.
+	MaxPolls int
+	// BaseDelay is the wait after the first empty poll and doubles after each later one.
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between polls.
+	MaxDelay time.Duration
+}
+
+// delay returns the wait before re-polling after the given number of consecutive empty polls.
+func (b ReadyPollBackoff) delay(emptyPolls int) time.Duration {
+	delay := b.BaseDelay
+	for i := 1; i < emptyPolls && delay < b.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, b.MaxDelay)
+}
+
 // HaltReason is a deterministic reason enum for mission halts.
 type HaltReason string
 
@@ -58,8 +166,54 @@ const (
 	HaltReasonACExhausted HaltReason = "ACExhausted"
 	// HaltReasonManualHalt indicates an operator-initiated or explicit manual halt.
 	HaltReasonManualHalt HaltReason = "ManualHalt"
+	// HaltReasonPreflightFailed indicates a mission preflight command failed before dispatch.
+	HaltReasonPreflightFailed HaltReason = "PreflightFailed"
+	// HaltReasonEmptyReviewFeedback indicates a reviewer requested fixes without saying what to fix.
+	HaltReasonEmptyReviewFeedback HaltReason = "EmptyReviewFeedback"
+	// HaltReasonContextCancelled indicates the execution context was cancelled, for example by a TUI halt.
+	HaltReasonContextCancelled HaltReason = "ContextCancelled"
+	// HaltReasonMissionTimeout indicates the mission ran past its configured MissionTimeout.
+	HaltReasonMissionTimeout HaltReason = "MissionTimeout"
+	// HaltReasonMaxExecutionDuration indicates the commission ran past its MaxExecutionDuration.
+	HaltReasonMaxExecutionDuration HaltReason = "MaxExecutionDuration"
+	// HaltReasonCriticalMissionHalted indicates a CriticalPath mission halted, so the commission aborted.
+	HaltReasonCriticalMissionHalted HaltReason = "CriticalMissionHalted"
+	// HaltReasonUncommittedChanges indicates the worktree still had uncommitted changes when
+	// the mission would have completed.
+	HaltReasonUncommittedChanges HaltReason = "UncommittedChanges"
+)
+
+// EmptyFeedbackPolicy selects how a NEEDS_FIXES verdict with no feedback or gates is handled.
+type EmptyFeedbackPolicy string
+
+const (
+	// EmptyFeedbackPolicyRevise dispatches a revision anyway; this is the default.
+	EmptyFeedbackPolicyRevise EmptyFeedbackPolicy = "revise"
+	// EmptyFeedbackPolicyHalt halts the mission with HaltReasonEmptyReviewFeedback.
+	EmptyFeedbackPolicyHalt EmptyFeedbackPolicy = "halt"
+	// EmptyFeedbackPolicyClarify re-dispatches the reviewer once and halts if feedback is still empty.
+	EmptyFeedbackPolicyClarify EmptyFeedbackPolicy = "clarify"
+)
+
+// RevisionDiffMode selects which revision history a reviewer receives after NEEDS_FIXES.
+type RevisionDiffMode string
+
+const (
+	// RevisionDiffsOff sends only the latest worktree diff; this is the default.
+	RevisionDiffsOff RevisionDiffMode = ""
+	// RevisionDiffsPerRevision sends one diff for each step between reviewed revisions.
+	RevisionDiffsPerRevision RevisionDiffMode = "per_revision"
+	// RevisionDiffsCumulative sends one diff from the first reviewed revision to the current one.
+	RevisionDiffsCumulative RevisionDiffMode = "cumulative"
 )
 
+// RevisionDiff is the change between two reviewed revisions of a mission's worktree.
+type RevisionDiff struct {
+	FromRevision int
+	ToRevision   int
+	Diff         string
+}
+
 // Mission is an executable mission in an approved manifest.
 type Mission struct {
 	ID                         string
@@ -85,6 +239,31 @@ type Mission struct {
 	ManualHalt bool
 	// AcceptanceCriteria are forwarded to reviewer context for independent validation.
 	AcceptanceCriteria []string
+	// ACRefs are the commission AC IDs this mission satisfies. Reviewers report ac_results
+	// against them and mission AC progress is measured over them.
+	ACRefs []string
+	// Priority ranks urgency within a wave; higher values are more urgent and zero is normal.
+	// Ready missions start in priority-descending, then ID-ascending order.
+	Priority int
+	// Exclusive missions run alone, never alongside other missions in the same batch.
+	Exclusive bool
+	// Sandboxed missions get a worktree under an owner-only sandbox root, and their harness
+	// sessions run with writes confined to the worktree and network tools disabled.
+	Sandboxed bool
+	// PreflightCommands run in order in the worktree before dispatch, such as "npm install".
+	PreflightCommands []string
+	// AdditionalGates are extra checks a NEEDS_FIXES reviewer asked the next implementer to run.
+	AdditionalGates []string
+	// WaveHint is the earliest wave (1-based) the planner allowed for this mission; zero means
+	// dependencies alone decide. ComputeWaves drops empty waves, so the hint orders missions
+	// relative to each other and the final wave index may be lower than the hint.
+	WaveHint int
+	// Labels tag the mission (for example "frontend"); CommanderConfig.HarnessLabels maps
+	// them to a harness when Harness is empty.
+	Labels []string
+	// CriticalPath missions are load-bearing: their halt aborts the commission even when
+	// CommanderConfig.ContinueWaveOnMissionHalt is set.
+	CriticalPath bool
 }
 
 // Slug returns a URL-safe slug for branch naming.
@@ -98,6 +277,9 @@ func (m Mission) Slug() string {
 
 // Event is a protocol event emitted by the commander.
 type Event struct {
+	// Seq is a monotonic per-commander sequence number assigned at publish time, so consumers
+	// can order events and detect gaps when timestamps collide.
+	Seq       uint64
 	Type      string
 	MissionID string
 	WaveIndex int
@@ -105,6 +287,17 @@ type Event struct {
 	Message   string
 	Reason    HaltReason
 	NotifyTUI bool
+	// AffectedMissionIDs lists missions impacted by the event, such as the next wave receiving feedback.
+	AffectedMissionIDs []string
+	// WaitingOn lists the unmet dependency IDs a mission is blocked on in EventMissionWaiting.
+	WaitingOn []string
+	// TranscriptRef references the reviewer transcript behind a completion, for traceability.
+	TranscriptRef string
+	// ACResults carries per-acceptance-criterion reviewer results behind a completion.
+	ACResults []ACResult
+	// Phase and Percent describe harness progress on EventMissionProgress events.
+	Phase   string
+	Percent int
 }
 
 // DispatchRequest contains mission dispatch details for harness implementations.
@@ -115,6 +308,39 @@ type DispatchRequest struct {
 	WaveFeedback string
 	// ReviewerFeedback is populated when a prior review returned NEEDS_FIXES.
 	ReviewerFeedback string
+	// SessionName is the deterministic harness session name operators can attach to.
+	SessionName string
+	// AdditionalGates are reviewer-requested checks the implementer must run before claiming done.
+	AdditionalGates []string
+	// Prompt is the rendered implementer prompt composed by the commander.
+	Prompt string
+	// Progress receives intermediate progress from harnesses that stream it; others may ignore it.
+	// It is nil for requests built by ComposeDispatch.
+	Progress ProgressReporter
+}
+
+// MissionProgress is one intermediate progress update from a running harness session.
+type MissionProgress struct {
+	Phase string
+	// Percent is clamped to 0-100 when published.
+	Percent int
+	Message string
+}
+
+// ProgressReporter forwards harness progress updates to commander events.
+type ProgressReporter interface {
+	ReportProgress(ctx context.Context, progress MissionProgress) error
+}
+
+// Validate reports missing fields a harness needs to dispatch an implementer.
+func (r DispatchRequest) Validate() error {
+	if strings.TrimSpace(r.Mission.ID) == "" {
+		return errors.New("dispatch request mission id must not be empty")
+	}
+	if strings.TrimSpace(r.WorktreePath) == "" {
+		return fmt.Errorf("dispatch request for mission %s: worktree path must not be empty", r.Mission.ID)
+	}
+	return nil
 }
 
 // ReviewerDispatchRequest contains reviewer context payload.
@@ -128,19 +354,62 @@ type ReviewerDispatchRequest struct {
 	ImplementerSessionID        string
 	ReadOnlyWorktree            bool
 	IncludeImplementerReasoning bool
+	// ACRefs are the commission AC IDs the reviewer reports ac_results against.
+	ACRefs []string
+	// RevisionDiffs holds the changes made between earlier reviewed revisions, oldest first,
+	// when CommanderConfig.RevisionDiffs is enabled.
+	RevisionDiffs []RevisionDiff
+}
+
+// Validate reports missing fields a harness needs to dispatch a reviewer.
+func (r ReviewerDispatchRequest) Validate() error {
+	if strings.TrimSpace(r.Mission.ID) == "" {
+		return errors.New("reviewer dispatch request mission id must not be empty")
+	}
+	if strings.TrimSpace(r.WorktreePath) == "" {
+		return fmt.Errorf("reviewer dispatch request for mission %s: worktree path must not be empty", r.Mission.ID)
+	}
+	return nil
 }
 
 // DispatchResult captures dispatch metadata from a harness implementation.
 type DispatchResult struct {
 	SessionID string
+	// TranscriptRef points at the session transcript (path or URL) for audit.
+	TranscriptRef string
 }
 
 // ManifestStore reads mission manifests and ready mission IDs from Beads.
+//
+// ReadApprovedManifest carries each mission's Beads labels in Mission.Labels. Missions that
+// leave Mission.Priority at zero take their intra-wave priority from a "priority:<n>" label.
+// Priority only orders dispatch within a wave; wave assignment comes from dependencies and
+// wave hints alone.
 type ManifestStore interface {
 	ReadApprovedManifest(ctx context.Context, commissionID string) ([]Mission, error)
 	ReadyMissionIDs(ctx context.Context, commissionID string) ([]string, error)
 }
 
+// priorityLabelPrefix marks the Beads label that carries a mission's dispatch priority.
+const priorityLabelPrefix = "priority:"
+
+// priorityFromLabels returns the integer from the first well-formed "priority:<n>" label,
+// or zero (normal priority) when none is present.
+func priorityFromLabels(labels []string) int {
+	for _, label := range labels {
+		value, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(label)), priorityLabelPrefix)
+		if !ok {
+			continue
+		}
+		priority, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		return priority
+	}
+	return 0
+}
+
 // WorktreeManager creates mission worktrees.
 type WorktreeManager interface {
 	Create(ctx context.Context, mission Mission) (string, error)
@@ -183,11 +452,95 @@ type PlanShelver interface {
 	ShelvePlan(ctx context.Context, commissionID, feedbackText string) error
 }
 
+// WaveFeedbackStore persists wave checkpoint feedback against a commission for audit.
+type WaveFeedbackStore interface {
+	RecordWaveFeedback(ctx context.Context, commissionID string, record WaveFeedbackRecord) error
+}
+
+// WaveFeedbackRecord is Admiral feedback captured at one wave checkpoint.
+type WaveFeedbackRecord struct {
+	WaveIndex  int
+	Feedback   string
+	RecordedAt time.Time
+}
+
+// ExecutionReport summarizes the most recent Execute run.
+type ExecutionReport struct {
+	CommissionID        string
+	CompletedMissionIDs []string
+	// SkippedMissionIDs lists missions never dispatched because a dependency halted.
+	SkippedMissionIDs []string
+	WaveFeedback      []WaveFeedbackRecord
+}
+
 // EventPublisher publishes protocol events for mission status changes.
 type EventPublisher interface {
 	Publish(ctx context.Context, event Event) error
 }
 
+// Logger captures commander warnings that do not change mission outcomes.
+type Logger interface {
+	Printf(format string, args ...any)
+}
+
+// MetricsRecorder receives per-commission execution metrics for dashboards. Outcome is the
+// terminal event type (EventMissionCompleted or EventMissionHalted).
+type MetricsRecorder interface {
+	RecordMissionOutcome(missionID string, outcome string, revisions int, dur time.Duration)
+	RecordWaveDuration(waveIndex int, dur time.Duration)
+}
+
+// NotificationSink receives NotifyTUI events so headless runs can surface them without a TUI.
+type NotificationSink interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// WriterNotificationSink writes NotifyTUI events as single lines to an io.Writer such as stderr.
+type WriterNotificationSink struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterNotificationSink creates a notification sink that writes to writer.
+func NewWriterNotificationSink(writer io.Writer) *WriterNotificationSink {
+	return &WriterNotificationSink{writer: writer}
+}
+
+// Notify writes one formatted event line.
+func (s *WriterNotificationSink) Notify(_ context.Context, event Event) error {
+	if s == nil || s.writer == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	line := fmt.Sprintf("%s %s", event.Timestamp.UTC().Format(time.RFC3339), event.Type)
+	if event.WaveIndex > 0 {
+		line += fmt.Sprintf(" wave=%d", event.WaveIndex)
+	}
+	if event.MissionID != "" {
+		line += " mission=" + event.MissionID
+	}
+	if event.Reason != "" {
+		line += " reason=" + string(event.Reason)
+	}
+	if message := strings.TrimSpace(event.Message); message != "" {
+		line += " message=" + message
+	}
+	_, err := fmt.Fprintln(s.writer, line)
+	return err
+}
+
+// CompletionStore reports missions completed by a prior run so resumed execution does not redo them.
+type CompletionStore interface {
+	IsMissionCompleted(ctx context.Context, missionID string) (bool, error)
+}
+
+// CommissionHaltStore reports whether an operator engaged the commission-wide kill switch.
+type CommissionHaltStore interface {
+	IsCommissionHalted(ctx context.Context, commissionID string) (bool, error)
+}
+
 // ProtocolEventStore provides mission-scoped protocol history used by reviewer flows.
 type ProtocolEventStore interface {
 	ListByMission(ctx context.Context, missionID string) ([]protocol.ProtocolEvent, error)
@@ -197,6 +550,26 @@ type ProtocolEventStore interface {
 type ReviewVerdict struct {
 	Decision string
 	Feedback string
+	// TranscriptRef references the reviewer session transcript that produced the verdict.
+	TranscriptRef string
+	// ReviewerSessionID identifies the reviewer session that produced the verdict.
+	ReviewerSessionID string
+	// ACResults lists per-acceptance-criterion outcomes when the reviewer reported them.
+	ACResults []ACResult
+	// AdditionalGates lists extra gates (for example a fuzz run) requested on NEEDS_FIXES.
+	AdditionalGates []string
+}
+
+// ACResult is a reviewer's pass/fail judgement for one acceptance criterion.
+type ACResult struct {
+	ACID   string `json:"ac_id"`
+	Passed bool   `json:"passed"`
+}
+
+// WaveReviewOutcome captures the Admiral decision and feedback from a wave checkpoint.
+type WaveReviewOutcome struct {
+	Decision admiral.ApprovalDecision
+	Feedback string
 }
 
 // CommanderConfig configures commander runtime behavior.
@@ -205,6 +578,114 @@ type CommanderConfig struct {
 	ProtocolEventStore ProtocolEventStore
 	ReviewPollInterval time.Duration
 	ReviewTimeout      time.Duration
+	// PerWaveWIPLimits overrides WIPLimit for specific waves, keyed by 1-based wave index.
+	PerWaveWIPLimits map[int]int
+	// GateEvidenceBudget caps reviewer gate evidence bytes; older results are summarized beyond it.
+	GateEvidenceBudget int
+	// GateEvidenceLookback limits reviewer gate evidence to the most recent N mission protocol
+	// events; zero scans the full history.
+	GateEvidenceLookback int
+	// NotificationSink optionally forwards NotifyTUI events when no TUI is attached.
+	NotificationSink NotificationSink
+	// CompletionStore optionally reports missions completed before this run started.
+	CompletionStore CompletionStore
+	// CommissionHalt is consulted before each batch; when engaged the commission halts mid-wave.
+	CommissionHalt CommissionHaltStore
+	// RequireDemoTokens validates each STANDARD_OPS mission's demo token before completion.
+	// Nil defaults to true; false suits early-stage commissions that produce no demo tokens yet.
+	RequireDemoTokens *bool
+	// RequireFreshDemoToken halts missions whose demo token predates the worktree's last commit,
+	// unless that commit added or changed the token and it is unchanged since.
+	RequireFreshDemoToken bool
+	// RequireCleanWorktree halts missions with HaltReasonUncommittedChanges when their
+	// worktree still has uncommitted changes at completion.
+	RequireCleanWorktree bool
+	// DefaultClassification is applied to missions that reach the commander unclassified.
+	// Empty keeps the RED_ALERT path.
+	DefaultClassification string
+	// Logger receives non-fatal warnings; defaults to the standard library logger.
+	Logger Logger
+	// EventLogSize bounds the in-memory recent-events buffer exposed by RecentEvents.
+	EventLogSize int
+	// NotificationCoalesceWindow above zero throttles NotificationSink delivery: rapid progress
+	// events for one mission coalesce to the latest within the window, which is delivered when
+	// the window closes. The event publisher still receives every event.
+	NotificationCoalesceWindow time.Duration
+	// EventBatchSize above 1 buffers published events into batches of this size for
+	// backends with per-call overhead. Buffered events are flushed when Execute returns.
+	EventBatchSize int
+	// EventFlushInterval flushes a partial batch once its oldest event has waited this long,
+	// even when no further events are published. Interval flush errors are logged.
+	EventFlushInterval time.Duration
+	// DemoTokenRetries is how many times a transient demo token validation error is retried
+	// before halting. Zero uses the default; a negative value disables retries.
+	DemoTokenRetries int
+	// DemoTokenRetryBackoff is the pause between demo token validation retries.
+	DemoTokenRetryBackoff time.Duration
+	// DispatchRetry retries implementer dispatches that fail with a RetriableError or
+	// harness.ErrTransient using exponential backoff; non-retriable failures still halt
+	// immediately.
+	DispatchRetry DispatchRetry
+	// DemoTokenPaths lists candidate demo token path templates relative to the worktree, tried
+	// in order; "{mission_id}" is replaced with the mission ID. Empty uses demo/MISSION-<id>.md.
+	DemoTokenPaths []string
+	// RedactDemoTokens masks secret-like values (bearer tokens, API keys) in demo token
+	// content before it is forwarded to reviewer prompts.
+	RedactDemoTokens bool
+	// EmitWaitingEvents publishes EventMissionWaiting when a wave mission is first seen not ready.
+	EmitWaitingEvents bool
+	// WaveFeedbackStore optionally persists Admiral wave checkpoint feedback for audit.
+	WaveFeedbackStore WaveFeedbackStore
+	// NonTerminalReviewVerdicts lists intermediate reviewer statuses (for example IN_PROGRESS)
+	// that mean "keep waiting". When set, any other verdict besides APPROVED, NEEDS_FIXES, and
+	// ESCALATE halts the mission. When empty, unrecognized verdicts are ignored and the
+	// commander keeps polling for a recognized one.
+	NonTerminalReviewVerdicts []string
+	// VerdictSchema adds review payload keys for reviewer harnesses whose field names differ
+	// from the built-in ones; the built-in keys still apply and take precedence.
+	VerdictSchema VerdictSchema
+	// ManifestSnapshotDir, when set, receives a JSON snapshot of each approved manifest that
+	// ReplayFromSnapshot can re-run.
+	ManifestSnapshotDir string
+	// ExecutionHistory optionally records duration, revisions, and outcome for each mission
+	// that completes or halts.
+	ExecutionHistory ExecutionHistoryStore
+	// Metrics optionally records mission outcomes and wave durations; nil disables metrics.
+	Metrics MetricsRecorder
+	// CommandRunner runs mission preflight commands; defaults to traced local execution.
+	CommandRunner CommandRunner
+	// MissionTimeout bounds each mission's end-to-end run; zero disables the deadline.
+	MissionTimeout time.Duration
+	// RedAlertTimeoutMultiplier scales MissionTimeout for RED_ALERT missions, whose verify and
+	// review cycle runs longer. Values at or below 1 keep RED_ALERT on the base timeout.
+	RedAlertTimeoutMultiplier float64
+	// MaxExecutionDuration caps one Execute call. On expiry in-flight missions drain and the
+	// commission halts with HaltReasonMaxExecutionDuration; zero leaves execution unbounded.
+	MaxExecutionDuration time.Duration
+	// RedAlertMinReviewers above 1 dispatches that many distinct reviewer sessions for each
+	// RED_ALERT review round; every reviewer must approve and any NEEDS_FIXES triggers revision.
+	RedAlertMinReviewers int
+	// EmptyFeedbackPolicy handles NEEDS_FIXES verdicts that carry no feedback or gates.
+	// Empty uses EmptyFeedbackPolicyRevise.
+	EmptyFeedbackPolicy EmptyFeedbackPolicy
+	// HarnessLabels maps mission labels to a harness, applied to missions with no Harness.
+	// The first of a mission's labels with a mapping wins.
+	HarnessLabels map[string]string
+	// ContinueWaveOnMissionHalt keeps a wave running when one of its missions halts; the
+	// halted mission IDs are reported to the Admiral in the wave review instead of aborting.
+	ContinueWaveOnMissionHalt bool
+	// ReadyPoll backs off between ReadyMissionIDs polls that find no ready wave missions
+	// instead of failing the wave at once; progress resets the backoff.
+	ReadyPoll ReadyPollBackoff
+	// RevisionDiffs adds the history of changes between reviewed revisions to reviewer
+	// requests after NEEDS_FIXES; empty sends only the latest diff.
+	RevisionDiffs RevisionDiffMode
+	// RevisionDiffBudget caps the bytes of revision diff history per reviewer request.
+	// The newest revisions are kept; zero uses the default budget.
+	RevisionDiffBudget int
+	// PreemptForExclusive lets a ready exclusive mission with positive priority run next,
+	// ahead of the remaining wave order, once in-flight missions have drained.
+	PreemptForExclusive bool
 }
 
 // Commander orchestrates mission execution from approved manifest through verification.
@@ -216,15 +697,91 @@ type Commander struct {
 	verifier      Verifier
 	demoTokens    DemoTokenValidator
 	approvalGate  ApprovalGate
+	// escalationMu serializes reviewer escalations: the approval gate answers requests in
+	// order on one shared channel, so concurrent missions must not have escalations in flight
+	// at the same time or one mission could receive another's decision.
+	escalationMu  sync.Mutex
 	feedback      FeedbackInjector
 	shelver       PlanShelver
 	events        EventPublisher
+	notifySink    NotificationSink
+	eventLog      *eventRing
+	progress      *progressTracker
+	eventSeqMu    sync.Mutex
+	eventSeq      uint64
 	protocolStore ProtocolEventStore
+	completions   CompletionStore
+	haltSwitch    CommissionHaltStore
+	runner        CommandRunner
+	feedbackLog   WaveFeedbackStore
+	history       ExecutionHistoryStore
+	metrics       MetricsRecorder
+	snapshotDir   string
+	waitVerdicts  map[string]struct{}
+	verdictSchema VerdictSchema
 	wipLimit      int
+	waveWIPLimits map[int]int
 	reviewPoll    time.Duration
 	reviewTimeout time.Duration
+	evidenceLimit int
+	evidenceScan  int
+	requireTokens bool
+	freshTokens   bool
+	requireClean  bool
+	tokenRetries  int
+	tokenBackoff  time.Duration
+	redactTokens  bool
+	tokenPaths    []string
+	dispatchRetry DispatchRetry
+	readyPoll     ReadyPollBackoff
+	revisionDiffs RevisionDiffMode
+	revDiffLimit  int
+	emitWaiting   bool
+	preempt       bool
+	continueWave  bool
+	timeout       time.Duration
+	redAlertScale float64
+	maxDuration   time.Duration
+	minReviewers  int
+	emptyFeedback EmptyFeedbackPolicy
+	defaultClass  string
+	harnessLabels map[string]string
+	logger        Logger
+	lastCommit    func(ctx context.Context, worktreePath string) (time.Time, error)
+	sleep         func(ctx context.Context, d time.Duration)
+	snapshot      func(ctx context.Context, worktreePath string) (string, error)
+	diffRefs      func(ctx context.Context, worktreePath, from, to string) (string, error)
+	revisionRefs  sync.Map
 	missionPaths  sync.Map
+	completed     sync.Map
+	halted        sync.Map
+	skipped       sync.Map
+	inFlight      sync.Map
 	now           func() time.Time
+	// newReplay builds a commander with the same dependencies and configuration around
+	// another manifest store, with fresh execution state and no completion store.
+	newReplay func(store ManifestStore) (*Commander, error)
+
+	reportMu sync.Mutex
+	report   ExecutionReport
+}
+
+// WithSettings returns cfg with the runtime settings loaded from sc3's config files applied:
+// the label-to-harness mapping, and the WIP limit when cfg leaves it unset.
+func (cfg CommanderConfig) WithSettings(settings *config.Config) CommanderConfig {
+	if settings == nil {
+		return cfg
+	}
+	if cfg.WIPLimit <= 0 && settings.WIPLimit > 0 {
+		cfg.WIPLimit = settings.WIPLimit
+	}
+	if len(settings.HarnessLabels) > 0 {
+		cfg.HarnessLabels = make(map[string]string, len(settings.HarnessLabels))
+		for label, harnessName := range settings.HarnessLabels {
+			cfg.HarnessLabels[label] = harnessName
+		}
+	}
+	return cfg
 }
 
 // New creates a Commander with required dependencies.
@@ -274,8 +831,67 @@ func New(
 	if cfg.WIPLimit <= 0 {
 		return nil, errors.New("wip limit must be positive")
 	}
+	waveWIPLimits := make(map[int]int, len(cfg.PerWaveWIPLimits))
+	for waveIndex, limit := range cfg.PerWaveWIPLimits {
+		if limit <= 0 {
+			return nil, fmt.Errorf("wip limit for wave %d must be positive", waveIndex)
+		}
+		waveWIPLimits[waveIndex] = limit
+	}
+	defaultClassification := strings.ToUpper(strings.TrimSpace(cfg.DefaultClassification))
+	if defaultClassification == "" {
+		defaultClassification = MissionClassificationREDAlert
+	}
+	if defaultClassification != MissionClassificationREDAlert && defaultClassification != MissionClassificationStandardOps {
+		return nil, fmt.Errorf("unsupported default classification %q", cfg.DefaultClassification)
+	}
+	emptyFeedback := EmptyFeedbackPolicy(strings.ToLower(strings.TrimSpace(string(cfg.EmptyFeedbackPolicy))))
+	if emptyFeedback == "" {
+		emptyFeedback = EmptyFeedbackPolicyRevise
+	}
+	switch emptyFeedback {
+	case EmptyFeedbackPolicyRevise, EmptyFeedbackPolicyHalt, EmptyFeedbackPolicyClarify:
+	default:
+		return nil, fmt.Errorf("unsupported empty feedback policy %q", cfg.EmptyFeedbackPolicy)
+	}
+	if err := cfg.VerdictSchema.validate(); err != nil {
+		return nil, err
+	}
+	var logger Logger = log.Default()
+	if cfg.Logger != nil {
+		logger = cfg.Logger
+	}
+
+	runner := cfg.CommandRunner
+	if runner == nil {
+		runner = commandRunner{}
+	}
+
+	baseEvents := events
+	if cfg.EventBatchSize > 1 {
+		batched, err := NewBatchingPublisher(events, cfg.EventBatchSize, cfg.EventFlushInterval)
+		if err != nil {
+			return nil, fmt.Errorf("configure event batching: %w", err)
+		}
+		batched.SetErrorHandler(func(err error) {
+			logger.Printf("commander: interval event flush failed: %v", err)
+		})
+		events = batched
+	}
+
+	notifySink := cfg.NotificationSink
+	if notifySink != nil && cfg.NotificationCoalesceWindow > 0 {
+		coalescing, err := NewCoalescingNotificationSink(notifySink, cfg.NotificationCoalesceWindow)
+		if err != nil {
+			return nil, fmt.Errorf("configure notification coalescing: %w", err)
+		}
+		coalescing.SetErrorHandler(func(err error) {
+			logger.Printf("commander: coalesced notification delivery failed: %v", err)
+		})
+		notifySink = coalescing
+	}
 
-	return &Commander{
+	c := &Commander{
 		manifestStore: store,
 		worktrees:     worktrees,
 		locks:         locks,
@@ -286,24 +902,98 @@ func New(
 		feedback:      feedback,
 		shelver:       shelver,
 		events:        events,
+		notifySink:    notifySink,
+		eventLog:      newEventRing(pickInt(cfg.EventLogSize, defaultEventLogSize)),
+		progress:      newProgressTracker(),
 		protocolStore: cfg.ProtocolEventStore,
+		completions:   cfg.CompletionStore,
+		haltSwitch:    cfg.CommissionHalt,
+		runner:        runner,
+		feedbackLog:   cfg.WaveFeedbackStore,
+		history:       cfg.ExecutionHistory,
+		metrics:       cfg.Metrics,
+		snapshotDir:   cfg.ManifestSnapshotDir,
+		waitVerdicts:  reviewVerdictSet(cfg.NonTerminalReviewVerdicts),
+		verdictSchema: cfg.VerdictSchema,
 		wipLimit:      cfg.WIPLimit,
+		waveWIPLimits: waveWIPLimits,
 		reviewPoll:    pickDuration(cfg.ReviewPollInterval, defaultReviewPollInterval),
 		reviewTimeout: pickDuration(cfg.ReviewTimeout, defaultReviewTimeout),
+		evidenceLimit: pickInt(cfg.GateEvidenceBudget, defaultGateEvidenceBudget),
+		evidenceScan:  cfg.GateEvidenceLookback,
+		requireTokens: cfg.RequireDemoTokens == nil || *cfg.RequireDemoTokens,
+		freshTokens:   cfg.RequireFreshDemoToken,
+		requireClean:  cfg.RequireCleanWorktree,
+		tokenRetries:  demoTokenRetries(cfg.DemoTokenRetries),
+		tokenBackoff:  pickDuration(cfg.DemoTokenRetryBackoff, defaultDemoTokenRetryBackoff),
+		redactTokens:  cfg.RedactDemoTokens,
+		tokenPaths:    append([]string(nil), cfg.DemoTokenPaths...),
+		dispatchRetry: DispatchRetry{
+			MaxAttempts: cfg.DispatchRetry.MaxAttempts,
+			BaseDelay:   pickDuration(cfg.DispatchRetry.BaseDelay, defaultDispatchRetryBaseDelay),
+		},
+		readyPoll: ReadyPollBackoff{
+			MaxPolls:  cfg.ReadyPoll.MaxPolls,
+			BaseDelay: pickDuration(cfg.ReadyPoll.BaseDelay, defaultReadyPollBaseDelay),
+			MaxDelay:  pickDuration(cfg.ReadyPoll.MaxDelay, defaultReadyPollMaxDelay),
+		},
+		revisionDiffs: cfg.RevisionDiffs,
+		revDiffLimit:  pickInt(cfg.RevisionDiffBudget, defaultRevisionDiffBudget),
+		emitWaiting:   cfg.EmitWaitingEvents,
+		preempt:       cfg.PreemptForExclusive,
+		continueWave:  cfg.ContinueWaveOnMissionHalt,
+		timeout:       cfg.MissionTimeout,
+		redAlertScale: cfg.RedAlertTimeoutMultiplier,
+		maxDuration:   cfg.MaxExecutionDuration,
+		minReviewers:  cfg.RedAlertMinReviewers,
+		emptyFeedback: emptyFeedback,
+		defaultClass:  defaultClassification,
+		harnessLabels: cfg.HarnessLabels,
+		logger:        logger,
+		sleep:         sleepContext,
+		snapshot:      gitSnapshot,
+		diffRefs:      gitDiffRefs,
 		now:           time.Now,
-	}, nil
+	}
+	c.lastCommit = func(ctx context.Context, worktreePath string) (time.Time, error) {
+		return gitLastCommitTime(ctx, runner, worktreePath)
+	}
+	c.newReplay = func(store ManifestStore) (*Commander, error) {
+		replayCfg := cfg
+		replayCfg.CompletionStore = nil
+		return New(store, worktrees, locks, harness, verifier, demoTokens, approvalGate, feedback, shelver, baseEvents, replayCfg)
+	}
+	return c, nil
 }
 
 // Execute runs the propulsion loop for an approved commission manifest.
-func (c *Commander) Execute(ctx context.Context, commissionID string) error {
+func (c *Commander) Execute(ctx context.Context, commissionID string) (err error) {
 	if strings.TrimSpace(commissionID) == "" {
 		return errors.New("commission id must not be empty")
 	}
+	flushCtx := ctx
+	defer func() {
+		if flushErr := c.flushEvents(flushCtx); flushErr != nil && err == nil {
+			err = fmt.Errorf("flush events: %w", flushErr)
+		}
+	}()
+	if c.maxDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, c.maxDuration, ErrMaxExecutionDuration)
+		defer cancel()
+	}
+
+	c.reportMu.Lock()
+	c.report = ExecutionReport{CommissionID: commissionID}
+	c.reportMu.Unlock()
 
 	manifest, err := c.manifestStore.ReadApprovedManifest(ctx, commissionID)
 	if err != nil {
 		return fmt.Errorf("read approved manifest: %w", err)
 	}
+	c.applyDefaultClassification(manifest)
+	c.applyHarnessLabels(manifest)
+	applyPriorityLabels(manifest)
 	waves, err := ComputeWaves(manifest)
 	if err != nil {
 		return fmt.Errorf("compute waves: %w", err)
@@ -311,147 +1001,723 @@ func (c *Commander) Execute(ctx context.Context, commissionID string) error {
 	if err := c.resolveAdmiralDecision(ctx, commissionID, manifest, waves); err != nil {
 		return err
 	}
+	if err := c.recordManifestSnapshot(commissionID, manifest); err != nil {
+		return fmt.Errorf("record manifest snapshot: %w", err)
+	}
 
 	waveFeedback := ""
+	var halted []string
 	for i, wave := range waves {
 		waveIndex := i + 1
-		if err := c.executeWave(ctx, commissionID, waveIndex, wave, waveFeedback); err != nil {
+		waveStarted := c.now()
+		waveHalted, err := c.executeWave(ctx, commissionID, waveIndex, wave, waveFeedback)
+		if c.metrics != nil {
+			c.metrics.RecordWaveDuration(waveIndex, c.now().Sub(waveStarted))
+		}
+		if err != nil {
 			return fmt.Errorf("execute wave %d: %w", i+1, err)
 		}
+		halted = append(halted, waveHalted...)
 		waveFeedback = ""
 		if i == len(waves)-1 {
 			continue
 		}
-		nextWaveFeedback, err := c.runWaveReview(ctx, commissionID, waveIndex, wave)
+		outcome, err := c.runWaveReview(ctx, commissionID, waveIndex, wave, waveHalted, waves[i+1])
 		if err != nil {
 			return err
 		}
-		waveFeedback = nextWaveFeedback
+		if outcome.Decision == admiral.ApprovalDecisionFeedback {
+			waveFeedback = outcome.Feedback
+		}
+	}
+	if len(halted) > 0 {
+		message := fmt.Sprintf("commission %s finished with halted missions: %s", commissionID, strings.Join(halted, ", "))
+		if skipped := c.Report().SkippedMissionIDs; len(skipped) > 0 {
+			message += fmt.Sprintf("; skipped dependents: %s", strings.Join(skipped, ", "))
+		}
+		return errors.New(message)
 	}
 
 	return nil
 }
 
+// applyDefaultClassification fills unclassified missions with the configured default so
+// routing is explicit rather than an accident of the STANDARD_OPS check.
+func (c *Commander) applyDefaultClassification(manifest []Mission) {
+	for i := range manifest {
+		if strings.TrimSpace(manifest[i].Classification) != "" {
+			continue
+		}
+		c.logger.Printf(
+			"commander: mission %s has no classification; defaulting to %s",
+			manifest[i].ID,
+			c.defaultClass,
+		)
+		manifest[i].Classification = c.defaultClass
+	}
+}
+
+// applyHarnessLabels resolves the harness for missions that pin none but carry a label
+// mapped in HarnessLabels.
+func (c *Commander) applyHarnessLabels(manifest []Mission) {
+	for i := range manifest {
+		if strings.TrimSpace(manifest[i].Harness) != "" {
+			continue
+		}
+		if harnessName := config.HarnessForLabels(c.harnessLabels, manifest[i].Labels); harnessName != "" {
+			manifest[i].Harness = harnessName
+		}
+	}
+}
+
+// applyPriorityLabels sets the dispatch priority of missions that carry none from their
+// "priority:<n>" label.
+func applyPriorityLabels(manifest []Mission) {
+	for i := range manifest {
+		if manifest[i].Priority == 0 {
+			manifest[i].Priority = priorityFromLabels(manifest[i].Labels)
+		}
+	}
+}
+
+// executeWave runs one wave to completion. With ContinueWaveOnMissionHalt it returns the IDs
+// of missions that halted instead of failing the wave. Missions that depend on a halted
+// mission can never become ready, so they are skipped rather than waited on.
 func (c *Commander) executeWave(
 	ctx context.Context,
 	commissionID string,
 	waveIndex int,
 	missions []Mission,
 	waveFeedback string,
-) error {
+) ([]string, error) {
 	if len(missions) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	pending := make(map[string]Mission, len(missions))
-	order := make([]string, 0, len(missions))
+	missionIDs := make([]string, 0, len(missions))
 	for _, mission := range missions {
 		mission.WaveFeedback = strings.TrimSpace(waveFeedback)
 		pending[mission.ID] = mission
-		order = append(order, mission.ID)
+		missionIDs = append(missionIDs, mission.ID)
 	}
+	c.progress.registerWave(waveIndex, missionIDs)
+	order := missionStartOrder(missions)
+	waiting := make(map[string]struct{}, len(missions))
+	var halted []string
+	emptyPolls := 0
 
 	for len(pending) > 0 {
+		if err := c.checkContextCancelled(ctx, waveIndex); err != nil {
+			return halted, err
+		}
+		if err := c.checkCommissionHalt(ctx, commissionID, waveIndex); err != nil {
+			return halted, err
+		}
+		if err := c.skipHaltedDependents(ctx, waveIndex, order, pending); err != nil {
+			return halted, err
+		}
+		if len(pending) == 0 {
+			break
+		}
+
 		readyIDs, err := c.manifestStore.ReadyMissionIDs(ctx, commissionID)
 		if err != nil {
-			return fmt.Errorf("query ready missions: %w", err)
+			return halted, fmt.Errorf("query ready missions: %w", err)
 		}
 
 		readySet := make(map[string]struct{}, len(readyIDs))
 		for _, id := range readyIDs {
 			readySet[id] = struct{}{}
 		}
+		if c.emitWaiting {
+			c.publishNewlyWaiting(ctx, waveIndex, order, pending, readySet, waiting)
+		}
+
+		batch := c.nextBatch(waveIndex, order, pending, readySet)
+		if len(batch) == 0 {
+			if c.readyPoll.MaxPolls >= 0 && emptyPolls >= c.readyPoll.MaxPolls {
+				return halted, fmt.Errorf("no unblocked missions available while %d missions remain in wave", len(pending))
+			}
+			emptyPolls++
+			// A cancelled wait is reported by checkContextCancelled at the top of the loop.
+			c.sleep(ctx, c.readyPoll.delay(emptyPolls))
+			continue
+		}
+		emptyPolls = 0
+
+		batchHalted, err := c.runBatch(ctx, waveIndex, batch)
+		halted = append(halted, batchHalted...)
+		if err != nil {
+			if errors.As(err, new(haltPublishedError)) {
+				// runBatch stopped dispatching on cancellation and already published the halt.
+				return halted, err
+			}
+			if ctxErr := c.checkContextCancelled(ctx, waveIndex); ctxErr != nil {
+				// The batch drained after cancellation; report the commission halt instead.
+				return halted, ctxErr
+			}
+			return halted, err
+		}
+		for _, mission := range batch {
+			delete(pending, mission.ID)
+		}
+	}
 
-		batch := make([]Mission, 0, c.wipLimit)
+	return halted, nil
+}
+
+// skipHaltedDependents drops pending missions whose dependencies halted, directly or through
+// another skipped mission, and publishes EventMissionSkipped for each.
+func (c *Commander) skipHaltedDependents(ctx context.Context, waveIndex int, order []string, pending map[string]Mission) error {
+	for changed := true; changed; {
+		changed = false
 		for _, id := range order {
 			mission, ok := pending[id]
 			if !ok {
 				continue
 			}
-			if _, ok := readySet[id]; !ok {
+			message := c.haltedDependency(mission)
+			if message == "" {
 				continue
 			}
-			batch = append(batch, mission)
-			if len(batch) == c.wipLimit {
-				break
+			delete(pending, id)
+			c.skipped.Store(id, struct{}{})
+			c.reportSkipped(id)
+			changed = true
+			c.logger.Printf("commander: wave %d skipping mission %s: %s", waveIndex, id, message)
+			if err := c.publish(ctx, Event{
+				Type:      EventMissionSkipped,
+				MissionID: id,
+				WaveIndex: waveIndex,
+				Timestamp: c.now().UTC(),
+				Message:   message,
+				NotifyTUI: true,
+			}); err != nil {
+				return fmt.Errorf("publish skip event for %s: %w", id, err)
 			}
 		}
+	}
+	return nil
+}
 
-		if len(batch) == 0 {
-			return fmt.Errorf("no unblocked missions available while %d missions remain in wave", len(pending))
+// haltedDependency describes the first dependency of mission that halted or was skipped, or
+// returns "" when every dependency can still complete.
+func (c *Commander) haltedDependency(mission Mission) string {
+	for _, dep := range mission.DependsOn {
+		dep = strings.TrimSpace(dep)
+		if _, done := c.completed.Load(dep); done || dep == "" {
+			continue
 		}
-
-		if err := c.runBatch(ctx, waveIndex, batch); err != nil {
-			return err
+		if c.missionHalted(dep) {
+			return fmt.Sprintf("dependency %s halted", dep)
 		}
-		for _, mission := range batch {
-			delete(pending, mission.ID)
+		if _, skipped := c.skipped.Load(dep); skipped {
+			return fmt.Sprintf("dependency %s was skipped", dep)
 		}
 	}
-
-	return nil
+	return ""
 }
 
-func (c *Commander) runBatch(ctx context.Context, waveIndex int, batch []Mission) error {
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(batch))
+// checkContextCancelled publishes EventCommissionHalted and returns the wrapped context error
+// once ctx is done, so an operator halt stops new dispatches without waiting for a store call.
+func (c *Commander) checkContextCancelled(ctx context.Context, waveIndex int) error {
+	select {
+	case <-ctx.Done():
+	default:
+		return nil
+	}
 
-	for _, mission := range batch {
-		mission := mission
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if err := c.runMission(ctx, waveIndex, mission); err != nil {
-				errCh <- err
-			}
-		}()
+	reason, message := HaltReasonContextCancelled, "execution context cancelled"
+	timedOut := errors.Is(context.Cause(ctx), ErrMaxExecutionDuration)
+	if timedOut {
+		reason = HaltReasonMaxExecutionDuration
+		message = fmt.Sprintf("execution exceeded maximum duration of %s", c.maxDuration)
+	}
+	if err := c.publish(context.WithoutCancel(ctx), Event{
+		Type:      EventCommissionHalted,
+		WaveIndex: waveIndex,
+		Timestamp: c.now().UTC(),
+		Message:   message,
+		Reason:    reason,
+		NotifyTUI: true,
+	}); err != nil {
+		return fmt.Errorf("publish commission halt: %w", err)
 	}
+	if timedOut {
+		return haltPublishedError{err: fmt.Errorf("wave %d halted: %w", waveIndex, ErrMaxExecutionDuration)}
+	}
+	return haltPublishedError{err: fmt.Errorf("wave %d cancelled: %w", waveIndex, ctx.Err())}
+}
 
-	wg.Wait()
-	close(errCh)
+// haltPublishedError marks a cancellation error whose EventCommissionHalted has already
+// been published, so callers further up the wave do not publish it again.
+type haltPublishedError struct {
+	err error
+}
+
+func (e haltPublishedError) Error() string { return e.err.Error() }
+
+func (e haltPublishedError) Unwrap() error { return e.err }
 
-	var errs []error
-	for err := range errCh {
-		errs = append(errs, err)
+// checkCommissionHalt consults the kill switch and, when engaged, publishes
+// EventCommissionHalted and returns ErrCommissionHalted so no further batches dispatch.
+func (c *Commander) checkCommissionHalt(ctx context.Context, commissionID string, waveIndex int) error {
+	if c.haltSwitch == nil {
+		return nil
 	}
-	if len(errs) == 0 {
+	halted, err := c.haltSwitch.IsCommissionHalted(ctx, commissionID)
+	if err != nil {
+		return fmt.Errorf("check commission kill switch: %w", err)
+	}
+	if !halted {
 		return nil
 	}
-	return errors.Join(errs...)
+
+	if err := c.publish(ctx, Event{
+		Type:      EventCommissionHalted,
+		WaveIndex: waveIndex,
+		Timestamp: c.now().UTC(),
+		Message:   "commission kill switch engaged",
+		NotifyTUI: true,
+	}); err != nil {
+		return fmt.Errorf("publish commission halt: %w", err)
+	}
+	return fmt.Errorf("commission %s: %w", commissionID, ErrCommissionHalted)
 }
 
-func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Mission) error {
-	if reason, message, shouldHalt := haltBeforeDispatch(mission); shouldHalt {
-		if reason == HaltReasonMaxRevisionsExceeded {
-			maxRevisions := mission.MaxRevisions
-			if maxRevisions <= 0 {
-				maxRevisions = DefaultMaxRevisions
-			}
-			invariants.CheckMaxRetriesNotExceeded(
-				ctx,
-				"commander.runMission",
-				mission.RevisionCount,
-				maxRevisions,
+// nextBatch selects the ready missions to admit next, in wave order, up to the WIP limit.
+//
+// Batches run to completion before the next admission, so in-flight work is always
+// drained here. Exclusive missions are admitted alone. With preemption enabled, the most
+// urgent ready exclusive mission is admitted ahead of the remaining wave order.
+func (c *Commander) nextBatch(
+	waveIndex int,
+	order []string,
+	pending map[string]Mission,
+	readySet map[string]struct{},
+) []Mission {
+	if c.preempt {
+		if mission, ok := preemptingMission(order, pending, readySet); ok {
+			c.logger.Printf(
+				"commander: wave %d preempted for exclusive mission %s (priority %d)",
+				waveIndex,
+				mission.ID,
+				mission.Priority,
 			)
+			return []Mission{mission}
 		}
-		_ = c.publishHalt(ctx, waveIndex, mission.ID, reason, message)
-		return fmt.Errorf("mission %s halted before dispatch: %s", mission.ID, message)
 	}
 
-	worktreePath, err := c.worktrees.Create(ctx, mission)
-	if err != nil {
-		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("worktree creation failed: %v", err))
-		return fmt.Errorf("create worktree for %s: %w", mission.ID, err)
+	limit := c.waveWIPLimit(waveIndex)
+	batch := make([]Mission, 0, limit)
+	for _, id := range order {
+		mission, ok := pending[id]
+		if !ok {
+			continue
+		}
+		if _, ok := readySet[id]; !ok {
+			continue
+		}
+		if mission.Exclusive {
+			if len(batch) == 0 {
+				return []Mission{mission}
+			}
+			continue
+		}
+		batch = append(batch, mission)
+		if len(batch) == limit {
+			break
+		}
 	}
-	c.missionPaths.Store(mission.ID, worktreePath)
-	cleanRepo, repoStatus := isGitWorktreeClean(ctx, worktreePath)
-	invariants.CheckRepoCleanBeforeMerge(
-		ctx,
-		"commander.runMission",
-		cleanRepo,
-		repoStatus,
-	)
-	invariants.CheckEditsWithinAllowedPaths(
+	return batch
+}
+
+// missionStartOrder returns wave mission IDs in the deterministic start order used for batch
+// selection: priority descending, then mission ID ascending. The order depends only on the
+// missions themselves, so batches are reproducible regardless of manifest or store ordering.
+func missionStartOrder(missions []Mission) []string {
+	sorted := slices.Clone(missions)
+	slices.SortStableFunc(sorted, func(a, b Mission) int {
+		if a.Priority != b.Priority {
+			return cmp.Compare(b.Priority, a.Priority)
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	order := make([]string, 0, len(sorted))
+	for _, mission := range sorted {
+		order = append(order, mission.ID)
+	}
+	return order
+}
+
+// waveWIPLimit returns the concurrency cap for a wave, falling back to the global WIP limit.
+func (c *Commander) waveWIPLimit(waveIndex int) int {
+	if limit, ok := c.waveWIPLimits[waveIndex]; ok {
+		return limit
+	}
+	return c.wipLimit
+}
+
+// preemptingMission returns the highest-priority ready exclusive mission with positive
+// priority. Ties keep wave order.
+func preemptingMission(order []string, pending map[string]Mission, readySet map[string]struct{}) (Mission, bool) {
+	var (
+		best  Mission
+		found bool
+	)
+	for _, id := range order {
+		mission, ok := pending[id]
+		if !ok || !mission.Exclusive || mission.Priority <= 0 {
+			continue
+		}
+		if _, ready := readySet[id]; !ready {
+			continue
+		}
+		if !found || mission.Priority > best.Priority {
+			best = mission
+			found = true
+		}
+	}
+	return best, found
+}
+
+// publishNewlyWaiting emits EventMissionWaiting for pending missions that are not
+// ready, once per mission, so repeated polls do not flood subscribers.
+func (c *Commander) publishNewlyWaiting(
+	ctx context.Context,
+	waveIndex int,
+	order []string,
+	pending map[string]Mission,
+	readySet map[string]struct{},
+	waiting map[string]struct{},
+) {
+	for _, id := range order {
+		mission, ok := pending[id]
+		if !ok {
+			continue
+		}
+		if _, ready := readySet[id]; ready {
+			continue
+		}
+		if _, seen := waiting[id]; seen {
+			continue
+		}
+		waiting[id] = struct{}{}
+
+		unmet := c.unmetDependencies(mission)
+		message := "waiting on dependencies"
+		if len(unmet) > 0 {
+			message += ": " + strings.Join(unmet, ", ")
+		}
+		_ = c.publish(ctx, Event{
+			Type:      EventMissionWaiting,
+			MissionID: id,
+			WaveIndex: waveIndex,
+			Timestamp: c.now().UTC(),
+			Message:   message,
+			NotifyTUI: true,
+			WaitingOn: unmet,
+		})
+	}
+}
+
+// unmetDependencies lists dependency IDs the commander has not seen complete.
+func (c *Commander) unmetDependencies(mission Mission) []string {
+	unmet := make([]string, 0, len(mission.DependsOn))
+	for _, dep := range mission.DependsOn {
+		dep = strings.TrimSpace(dep)
+		if dep == "" {
+			continue
+		}
+		if _, done := c.completed.Load(dep); done {
+			continue
+		}
+		unmet = append(unmet, dep)
+	}
+	return unmet
+}
+
+// runBatch runs a batch concurrently. Mission failures are returned as errors unless
+// ContinueWaveOnMissionHalt is set and the mission's halt was published, in which case the
+// halted mission IDs are returned instead; other failures still fail the wave.
+// A CriticalPath mission failure always aborts: the batch context is cancelled so siblings
+// still running stop immediately, EventCommissionHalted is published, and the error wraps
+// ErrCriticalMissionHalted.
+func (c *Commander) runBatch(ctx context.Context, waveIndex int, batch []Mission) ([]string, error) {
+	type missionFailure struct {
+		missionID string
+		critical  bool
+		err       error
+	}
+	batchCtx, abortBatch := context.WithCancelCause(ctx)
+	defer abortBatch(nil)
+
+	var (
+		wg        sync.WaitGroup
+		abortOnce sync.Once
+	)
+	failures := make(chan missionFailure, len(batch)+2)
+	abort := func(missionID string) {
+		abortOnce.Do(func() {
+			abortBatch(ErrCriticalMissionHalted)
+			if err := c.publish(context.WithoutCancel(ctx), Event{
+				Type:      EventCommissionHalted,
+				WaveIndex: waveIndex,
+				Timestamp: c.now().UTC(),
+				Message:   fmt.Sprintf("critical-path mission halted: %s", missionID),
+				Reason:    HaltReasonCriticalMissionHalted,
+				NotifyTUI: true,
+			}); err != nil {
+				failures <- missionFailure{err: fmt.Errorf("publish commission halt: %w", err)}
+			}
+		})
+	}
+
+	for _, mission := range batch {
+		if err := c.checkContextCancelled(ctx, waveIndex); err != nil {
+			failures <- missionFailure{err: err}
+			break
+		}
+		if batchCtx.Err() != nil {
+			break
+		}
+		mission := mission
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.runMission(batchCtx, waveIndex, mission); err != nil {
+				if mission.CriticalPath {
+					abort(mission.ID)
+				}
+				failures <- missionFailure{missionID: mission.ID, critical: mission.CriticalPath, err: err}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(failures)
+
+	aborted := errors.Is(context.Cause(batchCtx), ErrCriticalMissionHalted)
+	var (
+		errs   []error
+		halted []string
+	)
+	for failure := range failures {
+		if failure.critical {
+			errs = append(errs, fmt.Errorf("mission %s: %w: %w", failure.missionID, ErrCriticalMissionHalted, failure.err))
+			continue
+		}
+		if aborted && failure.missionID != "" {
+			c.logger.Printf("commander: wave %d mission %s stopped by critical-path abort: %v", waveIndex, failure.missionID, failure.err)
+			continue
+		}
+		if c.continueWave && c.missionHalted(failure.missionID) && ctx.Err() == nil {
+			c.logger.Printf("commander: wave %d continuing after mission %s halted: %v", waveIndex, failure.missionID, failure.err)
+			halted = append(halted, failure.missionID)
+			continue
+		}
+		errs = append(errs, failure.err)
+	}
+	sort.Strings(halted)
+	if len(errs) == 0 {
+		return halted, nil
+	}
+	return halted, errors.Join(errs...)
+}
+
+// operatorCancelledMessage is the halt message published by CancelMission.
+const operatorCancelledMessage = "operator cancelled"
+
+// inFlightMission tracks the cancellation handle for one running mission.
+type inFlightMission struct {
+	waveIndex int
+	cancel    context.CancelFunc
+	cancelled atomic.Bool
+	startedAt time.Time
+	revisions atomic.Int64
+}
+
+// CancelMission aborts one in-flight mission without halting the commission.
+//
+// The mission's dispatch context is cancelled, its surface-area lock is released
+// as the mission unwinds, and a halt is published with HaltReasonManualHalt.
+// Sibling missions keep running.
+func (c *Commander) CancelMission(ctx context.Context, missionID string) error {
+	missionID = strings.TrimSpace(missionID)
+	if missionID == "" {
+		return errors.New("mission id must not be empty")
+	}
+	value, ok := c.inFlight.Load(missionID)
+	if !ok {
+		return fmt.Errorf("mission %s is not in flight", missionID)
+	}
+	handle := value.(*inFlightMission)
+	if !handle.cancelled.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	c.markMissionHalted(missionID)
+	err := c.publish(ctx, Event{
+		Type:      EventMissionHalted,
+		MissionID: missionID,
+		WaveIndex: handle.waveIndex,
+		Timestamp: c.now().UTC(),
+		Message:   operatorCancelledMessage,
+		Reason:    HaltReasonManualHalt,
+		NotifyTUI: true,
+	})
+	handle.cancel()
+	if err != nil {
+		return fmt.Errorf("publish cancel event for %s: %w", missionID, err)
+	}
+	return nil
+}
+
+// missionCancelled reports whether an operator cancelled the mission via CancelMission.
+func (c *Commander) missionCancelled(missionID string) bool {
+	value, ok := c.inFlight.Load(missionID)
+	return ok && value.(*inFlightMission).cancelled.Load()
+}
+
+// releaseMissionState drops per-mission state that is only needed while the mission can
+// still be revised: its revision snapshots and any gate results the verifier cached.
+func (c *Commander) releaseMissionState(missionID string) {
+	c.revisionRefs.Delete(missionID)
+	if invalidator, ok := c.verifier.(gateCacheInvalidator); ok {
+		invalidator.InvalidateCache(missionID)
+	}
+}
+
+// markMissionHalted records that a mission's halt was reported, so the wave can continue past it
+// under ContinueWaveOnMissionHalt and its dependents are skipped.
+func (c *Commander) markMissionHalted(missionID string) {
+	c.halted.Store(missionID, struct{}{})
+	c.releaseMissionState(missionID)
+	c.recordMissionOutcome(missionID, EventMissionHalted)
+}
+
+// missionHalted reports whether a halt was published for the mission.
+func (c *Commander) missionHalted(missionID string) bool {
+	_, ok := c.halted.Load(missionID)
+	return ok
+}
+
+// recordMissionOutcome reports a terminal mission outcome to the metrics recorder, if any.
+func (c *Commander) recordMissionOutcome(missionID string, outcome string) {
+	if c.metrics == nil {
+		return
+	}
+	var (
+		revisions int
+		elapsed   time.Duration
+	)
+	if value, ok := c.inFlight.Load(missionID); ok {
+		handle := value.(*inFlightMission)
+		revisions = int(handle.revisions.Load())
+		elapsed = c.now().UTC().Sub(handle.startedAt)
+	}
+	c.metrics.RecordMissionOutcome(missionID, outcome, revisions, elapsed)
+}
+
+func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Mission) error {
+	missionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if timeout := c.missionTimeout(mission); timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		missionCtx, cancelTimeout = context.WithTimeoutCause(missionCtx, timeout, ErrMissionTimeout)
+		defer cancelTimeout()
+	}
+	handle := &inFlightMission{waveIndex: waveIndex, cancel: cancel, startedAt: c.now().UTC()}
+	handle.revisions.Store(int64(mission.RevisionCount))
+	c.inFlight.Store(mission.ID, handle)
+	defer c.inFlight.Delete(mission.ID)
+
+	err := c.executeMission(missionCtx, waveIndex, mission)
+	if err != nil && c.missionCancelled(mission.ID) {
+		// CancelMission already reported the halt; the rest of the wave continues.
+		return nil
+	}
+	if err != nil && errors.Is(context.Cause(missionCtx), ErrMissionTimeout) {
+		// Phase failures after the deadline are folded into a single timeout halt.
+		message := fmt.Sprintf("mission exceeded timeout of %s", c.missionTimeout(mission))
+		c.markMissionHalted(mission.ID)
+		_ = c.publish(ctx, Event{
+			Type:      EventMissionHalted,
+			MissionID: mission.ID,
+			WaveIndex: waveIndex,
+			Timestamp: c.now().UTC(),
+			Message:   message,
+			Reason:    HaltReasonMissionTimeout,
+			NotifyTUI: true,
+		})
+		return fmt.Errorf("mission %s halted: %w: %v", mission.ID, ErrMissionTimeout, err)
+	}
+	return err
+}
+
+// missionTimeout returns the mission's run deadline, extending the base timeout for
+// RED_ALERT missions by the configured multiplier.
+func (c *Commander) missionTimeout(mission Mission) time.Duration {
+	if c.timeout <= 0 {
+		return 0
+	}
+	if isStandardOpsMission(mission) || c.redAlertScale <= 1 {
+		return c.timeout
+	}
+	return time.Duration(float64(c.timeout) * c.redAlertScale)
+}
+
+func (c *Commander) executeMission(ctx context.Context, waveIndex int, mission Mission) error {
+	alreadyCompleted, err := c.missionAlreadyCompleted(ctx, mission.ID)
+	if err != nil {
+		return fmt.Errorf("check prior completion for %s: %w", mission.ID, err)
+	}
+	if alreadyCompleted {
+		c.reportCompleted(mission.ID)
+		if err := c.publish(ctx, Event{
+			Type:      EventMissionSkipped,
+			MissionID: mission.ID,
+			WaveIndex: waveIndex,
+			Timestamp: c.now().UTC(),
+			Message:   "mission already completed",
+		}); err != nil {
+			return fmt.Errorf("publish skip event for %s: %w", mission.ID, err)
+		}
+		return nil
+	}
+
+	if reason, message, shouldHalt := haltBeforeDispatch(mission); shouldHalt {
+		if reason == HaltReasonMaxRevisionsExceeded {
+			maxRevisions := mission.MaxRevisions
+			if maxRevisions <= 0 {
+				maxRevisions = DefaultMaxRevisions
+			}
+			invariants.CheckMaxRetriesNotExceeded(
+				ctx,
+				"commander.runMission",
+				mission.RevisionCount,
+				maxRevisions,
+			)
+		}
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, reason, message)
+		return fmt.Errorf("mission %s halted before dispatch: %s", mission.ID, message)
+	}
+
+	worktreePath, err := c.worktrees.Create(ctx, mission)
+	if err != nil {
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("worktree creation failed: %v", err))
+		return fmt.Errorf("create worktree for %s: %w", mission.ID, err)
+	}
+	c.missionPaths.Store(mission.ID, worktreePath)
+	cleanRepo, repoStatus := isGitWorktreeClean(ctx, worktreePath)
+	invariants.CheckRepoCleanBeforeMerge(
+		ctx,
+		"commander.runMission",
+		cleanRepo,
+		repoStatus,
+	)
+	invariants.CheckEditsWithinAllowedPaths(
 		ctx,
 		"commander.runMission",
 		mission.SurfaceArea,
@@ -463,10 +1729,17 @@ func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Missi
 		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("surface-area lock failed: %v", err))
 		return fmt.Errorf("acquire lock for %s: %w", mission.ID, err)
 	}
+	lockAcquiredAt := c.now()
 	defer func() {
 		_ = release()
+		c.recordSurfaceLockHold(ctx, mission, lockAcquiredAt, c.now())
 	}()
 
+	if err := c.runPreflight(ctx, mission, worktreePath); err != nil {
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonPreflightFailed, fmt.Sprintf("preflight failed: %v", err))
+		return fmt.Errorf("preflight for %s: %w", mission.ID, err)
+	}
+
 	maxRevisions := mission.MaxRevisions
 	if maxRevisions <= 0 {
 		maxRevisions = DefaultMaxRevisions
@@ -483,7 +1756,7 @@ func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Missi
 			return err
 		}
 
-		verdict, err := c.dispatchReviewerAndAwaitVerdict(
+		verdict, err := c.dispatchReviewers(
 			ctx,
 			currentMission,
 			worktreePath,
@@ -493,6 +1766,10 @@ func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Missi
 		if err != nil {
 			return err
 		}
+		verdict, err = c.resolveEmptyFeedback(ctx, currentMission, worktreePath, waveIndex, implementerResult.SessionID, verdict)
+		if err != nil {
+			return err
+		}
 
 		done, err := c.handleReviewVerdict(ctx, mission.ID, waveIndex, &currentMission, maxRevisions, verdict)
 		if err != nil {
@@ -504,12 +1781,146 @@ func (c *Commander) runMission(ctx context.Context, waveIndex int, mission Missi
 	}
 }
 
+// preflightOutputLimit caps preflight output recorded on telemetry spans.
+const preflightOutputLimit = 2048
+
+// runPreflight runs the mission's preflight commands in its worktree, stopping at the first
+// failure. Each command is recorded as a span carrying its (truncated) output.
+func (c *Commander) runPreflight(ctx context.Context, mission Mission, worktreePath string) error {
+	for _, command := range mission.PreflightCommands {
+		command = strings.TrimSpace(command)
+		if command == "" {
+			continue
+		}
+
+		spanCtx, span := otel.Tracer("sc3/commander").Start(
+			ctx,
+			"commander.preflight",
+			trace.WithAttributes(
+				attribute.String("mission_id", mission.ID),
+				attribute.String("command", command),
+			),
+		)
+		stdout, stderr, err := c.runner.Run(spanCtx, worktreePath, "sh", "-c", command)
+		span.SetAttributes(
+			attribute.String("stdout", truncateOutput(string(stdout), preflightOutputLimit)),
+			attribute.String("stderr", truncateOutput(string(stderr), preflightOutputLimit)),
+			attribute.Bool("ok", err == nil),
+		)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.End()
+			return fmt.Errorf("command %q: %w (stderr: %s)", command, err, strings.TrimSpace(string(stderr)))
+		}
+		span.End()
+	}
+	return nil
+}
+
+// truncateOutput keeps the trailing limit bytes of output, where failures usually surface.
+func truncateOutput(output string, limit int) string {
+	output = strings.TrimSpace(output)
+	if limit <= 0 || len(output) <= limit {
+		return output
+	}
+	return "..." + output[len(output)-limit:]
+}
+
+// missionAlreadyCompleted reports whether this commander or a prior run already completed the mission.
+func (c *Commander) missionAlreadyCompleted(ctx context.Context, missionID string) (bool, error) {
+	if _, ok := c.completed.Load(missionID); ok {
+		return true, nil
+	}
+	if c.completions == nil {
+		return false, nil
+	}
+	completed, err := c.completions.IsMissionCompleted(ctx, missionID)
+	if err != nil {
+		return false, err
+	}
+	if completed {
+		c.completed.Store(missionID, struct{}{})
+	}
+	return completed, nil
+}
+
+// recordSurfaceLockHold reports how long a mission held its surface-area lock so
+// missions that serialize a wave are visible in traces and metrics.
+func (c *Commander) recordSurfaceLockHold(ctx context.Context, mission Mission, acquiredAt, releasedAt time.Time) {
+	hold := releasedAt.Sub(acquiredAt)
+	if hold < 0 {
+		hold = 0
+	}
+	// The histogram only carries bounded attributes; the mission ID stays on the span.
+	attrs := []attribute.KeyValue{
+		attribute.Int("surface_area_count", len(mission.SurfaceArea)),
+		attribute.String("classification", normalizeClassification(mission.Classification)),
+	}
+
+	_, span := otel.Tracer("sc3/commander").Start(
+		ctx,
+		"commander.surface_lock",
+		trace.WithTimestamp(acquiredAt),
+		trace.WithAttributes(attrs...),
+	)
+	span.SetAttributes(
+		attribute.String("mission_id", mission.ID),
+		attribute.Int64("lock_hold_ms", hold.Milliseconds()),
+	)
+	span.End(trace.WithTimestamp(releasedAt))
+
+	histogram, err := otel.Meter("sc3/commander").Float64Histogram(
+		"sc3.commander.surface_lock.hold_ms",
+		metric.WithDescription("Duration a mission held its surface-area lock."),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return
+	}
+	histogram.Record(ctx, float64(hold)/float64(time.Millisecond), metric.WithAttributes(attrs...))
+}
+
+// ComposeDispatch builds the implementer dispatch request exactly as Execute would send it,
+// including wave and reviewer feedback and the rendered prompt, without spawning an agent.
+func (c *Commander) ComposeDispatch(mission Mission, worktreePath string) (DispatchRequest, error) {
+	return ComposeDispatchRequest(mission, worktreePath)
+}
+
+// ComposeDispatchRequest builds the implementer dispatch request for a mission as Execute
+// ran it, for callers such as `sc3 execute --explain` that have no running commander.
+func ComposeDispatchRequest(mission Mission, worktreePath string) (DispatchRequest, error) {
+	req := DispatchRequest{
+		Mission:          mission,
+		WorktreePath:     worktreePath,
+		WaveFeedback:     mission.WaveFeedback,
+		ReviewerFeedback: mission.ReviewFeedback,
+		AdditionalGates:  mission.AdditionalGates,
+		SessionName:      missionSessionName(mission),
+	}
+	if err := req.Validate(); err != nil {
+		return DispatchRequest{}, err
+	}
+	prompt, err := buildDispatchPrompt(req)
+	if err != nil {
+		return DispatchRequest{}, fmt.Errorf("compose prompt for mission %s: %w", mission.ID, err)
+	}
+	req.Prompt = prompt
+	return req, nil
+}
+
 func (c *Commander) dispatchImplementer(
 	ctx context.Context,
 	mission Mission,
 	worktreePath string,
 	waveIndex int,
 ) (DispatchResult, error) {
+	req, err := c.ComposeDispatch(mission, worktreePath)
+	if err != nil {
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("invalid dispatch request: %v", err))
+		return DispatchResult{}, fmt.Errorf("dispatch implementer for %s: %w", mission.ID, err)
+	}
+	req.Progress = &missionProgressReporter{commander: c, missionID: mission.ID, waveIndex: waveIndex}
+
 	dispatchCtx, llmCall := telemetry.StartLLMCall(ctx, telemetry.LLMCallRequest{
 		Operation: "dispatch_implementer",
 		ModelName: mission.Model,
@@ -517,12 +1928,7 @@ func (c *Commander) dispatchImplementer(
 		Prompt:    buildDispatchTelemetryPrompt(mission, waveIndex),
 	})
 
-	result, err := c.harness.DispatchImplementer(dispatchCtx, DispatchRequest{
-		Mission:          mission,
-		WorktreePath:     worktreePath,
-		WaveFeedback:     mission.WaveFeedback,
-		ReviewerFeedback: mission.ReviewFeedback,
-	})
+	result, err := c.dispatchImplementerWithRetry(dispatchCtx, mission, req, llmCall)
 	if err != nil {
 		llmCall.RecordError("implementer_dispatch_error", err.Error(), mission.RevisionCount)
 		llmCall.End("", nil, err)
@@ -533,6 +1939,64 @@ func (c *Commander) dispatchImplementer(
 	return result, nil
 }
 
+// missionProgressReporter publishes harness progress for one dispatched mission. Progress
+// feeds WaveProgress and the durable event log but is not pushed to the TUI.
+type missionProgressReporter struct {
+	commander *Commander
+	missionID string
+	waveIndex int
+}
+
+func (r *missionProgressReporter) ReportProgress(ctx context.Context, progress MissionProgress) error {
+	percent := min(max(progress.Percent, 0), 100)
+	if err := r.commander.publish(ctx, Event{
+		Type:      EventMissionProgress,
+		MissionID: r.missionID,
+		WaveIndex: r.waveIndex,
+		Timestamp: r.commander.now().UTC(),
+		Message:   strings.TrimSpace(progress.Message),
+		Phase:     strings.TrimSpace(progress.Phase),
+		Percent:   percent,
+	}); err != nil {
+		return fmt.Errorf("publish progress for %s: %w", r.missionID, err)
+	}
+	return nil
+}
+
+// dispatchImplementerWithRetry retries retriable dispatch failures with exponential
+// backoff, recording each retry on the llm call span with its attempt number.
+func (c *Commander) dispatchImplementerWithRetry(
+	ctx context.Context,
+	mission Mission,
+	req DispatchRequest,
+	llmCall *telemetry.LLMCall,
+) (DispatchResult, error) {
+	delay := c.dispatchRetry.BaseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := c.harness.DispatchImplementer(ctx, req)
+		if err == nil || attempt >= c.dispatchRetry.MaxAttempts || !IsRetriable(err) {
+			return result, err
+		}
+		llmCall.RecordError("implementer_dispatch_retry", err.Error(), attempt)
+		c.logger.Printf(
+			"commander: retriable implementer dispatch error for mission %s (attempt %d/%d): %v",
+			mission.ID,
+			attempt,
+			c.dispatchRetry.MaxAttempts,
+			err,
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return DispatchResult{}, err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
 func (c *Commander) verifyMissionOutput(
 	ctx context.Context,
 	mission Mission,
@@ -550,7 +2014,11 @@ func (c *Commander) verifyMissionOutput(
 			_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("verification failed: %v", err))
 			return fmt.Errorf("verify implement mission %s: %w", mission.ID, err)
 		}
-		if err := c.demoTokens.Validate(ctx, mission, worktreePath); err != nil {
+		if !c.requireTokens {
+			c.logger.Printf("commander: demo token validation disabled; skipping for mission %s", mission.ID)
+			return nil
+		}
+		if err := c.validateDemoToken(ctx, mission, worktreePath); err != nil {
 			_ = c.publishHalt(
 				ctx,
 				waveIndex,
@@ -560,6 +2028,18 @@ func (c *Commander) verifyMissionOutput(
 			)
 			return fmt.Errorf("validate demo token for %s: %w", mission.ID, err)
 		}
+		if c.freshTokens {
+			if err := c.checkDemoTokenFreshness(ctx, mission, worktreePath); err != nil {
+				_ = c.publishHalt(
+					ctx,
+					waveIndex,
+					mission.ID,
+					HaltReasonDemoTokenInvalid,
+					fmt.Sprintf("demo token freshness check failed: %v", err),
+				)
+				return fmt.Errorf("check demo token freshness for %s: %w", mission.ID, err)
+			}
+		}
 		return nil
 	}
 
@@ -576,18 +2056,306 @@ func (c *Commander) verifyMissionOutput(
 	return nil
 }
 
+// validateDemoToken validates a mission's demo token, retrying transient failures
+// so a brief filesystem hiccup does not halt the mission.
+func (c *Commander) validateDemoToken(ctx context.Context, mission Mission, worktreePath string) (err error) {
+	startedAt := time.Now()
+	defer func() {
+		c.recordDemoTokenValidation(ctx, mission, worktreePath, startedAt, time.Now(), err)
+	}()
+
+	for attempt := 0; ; attempt++ {
+		err := c.demoTokens.Validate(ctx, mission, worktreePath)
+		if err == nil || attempt >= c.tokenRetries || !isTransientDemoTokenError(err) {
+			return err
+		}
+		c.logger.Printf(
+			"commander: transient demo token validation error for mission %s (retry %d/%d): %v",
+			mission.ID,
+			attempt+1,
+			c.tokenRetries,
+			err,
+		)
+
+		timer := time.NewTimer(c.tokenBackoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// recordDemoTokenValidation emits a span and latency histogram for one demo token validation,
+// including the token's byte size when the file can be read.
+func (c *Commander) recordDemoTokenValidation(
+	ctx context.Context,
+	mission Mission,
+	worktreePath string,
+	startedAt time.Time,
+	finishedAt time.Time,
+	validationErr error,
+) {
+	elapsed := finishedAt.Sub(startedAt)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	outcome := "valid"
+	if validationErr != nil {
+		outcome = "invalid"
+	}
+	// The histogram only carries bounded attributes; per-mission detail stays on the span.
+	attrs := []attribute.KeyValue{
+		attribute.String("outcome", outcome),
+		attribute.String("classification", normalizeClassification(mission.Classification)),
+	}
+	spanAttrs := append([]attribute.KeyValue{attribute.String("mission_id", mission.ID)}, attrs...)
+	if tokenPath, err := c.demoTokenPath(worktreePath, mission.ID); err == nil {
+		if info, statErr := os.Stat(tokenPath); statErr == nil {
+			spanAttrs = append(spanAttrs, attribute.Int64("demo_token_bytes", info.Size()))
+		}
+	}
+
+	_, span := otel.Tracer("sc3/commander").Start(
+		ctx,
+		"commander.demo_token_validation",
+		trace.WithTimestamp(startedAt),
+		trace.WithAttributes(spanAttrs...),
+	)
+	span.SetAttributes(
+		attribute.Int64("validation_ms", elapsed.Milliseconds()),
+		attribute.Bool("valid", validationErr == nil),
+	)
+	if validationErr != nil {
+		span.SetStatus(codes.Error, telemetry.MaskSecrets(validationErr.Error()))
+	}
+	span.End(trace.WithTimestamp(finishedAt))
+
+	histogram, err := otel.Meter("sc3/commander").Float64Histogram(
+		"sc3.commander.demo_token.validation_ms",
+		metric.WithDescription("Duration of demo token validation, including retries."),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return
+	}
+	histogram.Record(ctx, float64(elapsed)/float64(time.Millisecond), metric.WithAttributes(attrs...))
+}
+
+// isTransientDemoTokenError reports whether a demo token validation failure may succeed on retry.
+// Missing tokens are always terminal.
+func isTransientDemoTokenError(err error) bool {
+	if err == nil || errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+	if errors.Is(err, ErrDemoTokenTransient) ||
+		errors.Is(err, syscall.EIO) ||
+		errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.ETIMEDOUT) {
+		return true
+	}
+	var temporary interface{ Temporary() bool }
+	return errors.As(err, &temporary) && temporary.Temporary()
+}
+
+func demoTokenRetries(configured int) int {
+	if configured < 0 {
+		return 0
+	}
+	return pickInt(configured, defaultDemoTokenRetries)
+}
+
+// checkDemoTokenFreshness rejects demo tokens last written before the worktree's latest commit,
+// which indicates the token was left over from earlier work. Git records commit times in whole
+// seconds, so the token's mtime is compared at second granularity, and a token the latest
+// commit added or changed is fresh as long as it is unchanged since.
+func (c *Commander) checkDemoTokenFreshness(ctx context.Context, mission Mission, worktreePath string) error {
+	tokenPath, err := c.demoTokenPath(worktreePath, mission.ID)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(tokenPath)
+	if err != nil {
+		return fmt.Errorf("stat demo token %s: %w", tokenPath, err)
+	}
+	committedAt, err := c.lastCommit(ctx, worktreePath)
+	if err != nil {
+		return fmt.Errorf("read last commit time: %w", err)
+	}
+	if !info.ModTime().Truncate(time.Second).Before(committedAt) {
+		return nil
+	}
+	if c.demoTokenCommittedAtHead(ctx, worktreePath, tokenPath) {
+		return nil
+	}
+	return fmt.Errorf(
+		"demo token modified %s predates last commit %s",
+		info.ModTime().UTC().Format(time.RFC3339),
+		committedAt.UTC().Format(time.RFC3339),
+	)
+}
+
+// demoTokenCommittedAtHead reports whether the worktree's latest commit added or changed the
+// demo token and the token has no uncommitted changes since.
+func (c *Commander) demoTokenCommittedAtHead(ctx context.Context, worktreePath, tokenPath string) bool {
+	rel, err := filepath.Rel(worktreePath, tokenPath)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	touched, _, err := c.runner.Run(ctx, worktreePath, "git", "diff-tree", "--root", "--no-commit-id", "--name-only", "-r", "HEAD", "--", rel)
+	if err != nil || strings.TrimSpace(string(touched)) == "" {
+		return false
+	}
+	status, _, err := c.runner.Run(ctx, worktreePath, "git", "status", "--porcelain", "--", rel)
+	return err == nil && strings.TrimSpace(string(status)) == ""
+}
+
+// checkWorktreeCommitted halts the mission when RequireCleanWorktree is set and its
+// worktree still has uncommitted changes, so unfinished work is never marked complete.
+func (c *Commander) checkWorktreeCommitted(ctx context.Context, missionID string, waveIndex int) error {
+	if !c.requireClean {
+		return nil
+	}
+	worktreePath, ok := c.missionPaths.Load(missionID)
+	if !ok {
+		return fmt.Errorf("worktree path missing for mission %s", missionID)
+	}
+	clean, status := isGitWorktreeClean(ctx, worktreePath.(string))
+	if clean {
+		return nil
+	}
+	message := fmt.Sprintf("worktree has uncommitted changes at completion: %s", status)
+	_ = c.publishHalt(ctx, waveIndex, missionID, HaltReasonUncommittedChanges, message)
+	return fmt.Errorf("mission %s not completed: %s", missionID, message)
+}
+
+// reviewersRequired returns how many independent reviewers must approve one review round.
+func (c *Commander) reviewersRequired(mission Mission) int {
+	if c.minReviewers > 1 && !isStandardOpsMission(mission) {
+		return c.minReviewers
+	}
+	return 1
+}
+
+// dispatchReviewers runs the mission's required reviewer sessions one after another and
+// combines their verdicts into the single verdict that drives completion or revision.
+func (c *Commander) dispatchReviewers(
+	ctx context.Context,
+	mission Mission,
+	worktreePath string,
+	waveIndex int,
+	implementerSessionID string,
+) (ReviewVerdict, error) {
+	required := c.reviewersRequired(mission)
+	reviewers := make([]string, 0, required)
+	verdicts := make([]ReviewVerdict, 0, required)
+	for len(verdicts) < required {
+		verdict, err := c.dispatchReviewerAndAwaitVerdict(ctx, mission, worktreePath, waveIndex, implementerSessionID, reviewers)
+		if err != nil {
+			return ReviewVerdict{}, err
+		}
+		reviewers = append(reviewers, verdict.ReviewerSessionID)
+		verdicts = append(verdicts, verdict)
+	}
+	return combineReviewVerdicts(verdicts), nil
+}
+
+// resolveEmptyFeedback applies the configured EmptyFeedbackPolicy to a NEEDS_FIXES verdict
+// that gives the implementer nothing to act on.
+func (c *Commander) resolveEmptyFeedback(
+	ctx context.Context,
+	mission Mission,
+	worktreePath string,
+	waveIndex int,
+	implementerSessionID string,
+	verdict ReviewVerdict,
+) (ReviewVerdict, error) {
+	if !isEmptyNeedsFixes(verdict) || c.emptyFeedback == EmptyFeedbackPolicyRevise {
+		return verdict, nil
+	}
+	if c.emptyFeedback == EmptyFeedbackPolicyClarify {
+		c.logger.Printf(
+			"commander: reviewer returned NEEDS_FIXES without feedback for mission %s; re-dispatching reviewer for clarification",
+			mission.ID,
+		)
+		clarified, err := c.dispatchReviewers(ctx, mission, worktreePath, waveIndex, implementerSessionID)
+		if err != nil {
+			return ReviewVerdict{}, err
+		}
+		if !isEmptyNeedsFixes(clarified) {
+			return clarified, nil
+		}
+	}
+
+	message := "reviewer requested fixes without feedback"
+	_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonEmptyReviewFeedback, message)
+	return ReviewVerdict{}, fmt.Errorf("mission %s halted after review: %s", mission.ID, message)
+}
+
+func isEmptyNeedsFixes(verdict ReviewVerdict) bool {
+	return verdict.Decision == protocol.ReviewVerdictNeedsFixes &&
+		strings.TrimSpace(verdict.Feedback) == "" &&
+		len(verdict.AdditionalGates) == 0
+}
+
+// combineReviewVerdicts approves only when every reviewer approved. Any NEEDS_FIXES wins
+// over approvals and carries the feedback and gates of every reviewer that requested fixes;
+// any other verdict (such as ESCALATE) is returned as-is for handleReviewVerdict to resolve.
+func combineReviewVerdicts(verdicts []ReviewVerdict) ReviewVerdict {
+	var needsFixes []ReviewVerdict
+	for _, verdict := range verdicts {
+		switch verdict.Decision {
+		case protocol.ReviewVerdictApproved:
+		case protocol.ReviewVerdictNeedsFixes:
+			needsFixes = append(needsFixes, verdict)
+		default:
+			return verdict
+		}
+	}
+	if len(needsFixes) == 0 {
+		return verdicts[0]
+	}
+
+	combined := needsFixes[0]
+	feedback := make([]string, 0, len(needsFixes))
+	gates := make([]string, 0)
+	for _, verdict := range needsFixes {
+		if text := strings.TrimSpace(verdict.Feedback); text != "" {
+			feedback = append(feedback, text)
+		}
+		for _, gate := range verdict.AdditionalGates {
+			if !containsString(gates, gate) {
+				gates = append(gates, gate)
+			}
+		}
+	}
+	combined.Feedback = strings.Join(feedback, "\n\n")
+	combined.AdditionalGates = nil
+	if len(gates) > 0 {
+		combined.AdditionalGates = gates
+	}
+	return combined
+}
+
 func (c *Commander) dispatchReviewerAndAwaitVerdict(
 	ctx context.Context,
 	mission Mission,
 	worktreePath string,
 	waveIndex int,
 	implementerSessionID string,
+	otherReviewers []string,
 ) (ReviewVerdict, error) {
 	reviewerReq, err := c.buildReviewerDispatchRequest(ctx, mission, worktreePath, implementerSessionID)
 	if err != nil {
 		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("build reviewer context failed: %v", err))
 		return ReviewVerdict{}, fmt.Errorf("build reviewer context for %s: %w", mission.ID, err)
 	}
+	if err := reviewerReq.Validate(); err != nil {
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, fmt.Sprintf("invalid reviewer dispatch request: %v", err))
+		return ReviewVerdict{}, fmt.Errorf("dispatch reviewer for %s: %w", mission.ID, err)
+	}
 
 	reviewCtx, llmCall := telemetry.StartLLMCall(ctx, telemetry.LLMCallRequest{
 		Operation: "dispatch_reviewer",
@@ -622,6 +2390,12 @@ func (c *Commander) dispatchReviewerAndAwaitVerdict(
 		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, "reviewer must be a different ensign session than implementer")
 		return ReviewVerdict{}, fmt.Errorf("dispatch reviewer for %s: reviewer and implementer session ids must differ", mission.ID)
 	}
+	if containsString(otherReviewers, reviewerSession) {
+		llmCall.RecordError("reviewer_session_invalid", "reviewers must be distinct sessions", mission.RevisionCount)
+		llmCall.End("", nil, errors.New("reviewer session ids must differ"))
+		_ = c.publishHalt(ctx, waveIndex, mission.ID, HaltReasonManualHalt, "reviewers must be distinct sessions")
+		return ReviewVerdict{}, fmt.Errorf("dispatch reviewer for %s: reviewer session %s already reviewed this round", mission.ID, reviewerSession)
+	}
 
 	verdict, err := c.awaitReviewVerdict(reviewCtx, mission.ID, implementerSession, reviewerSession)
 	if err != nil {
@@ -631,6 +2405,8 @@ func (c *Commander) dispatchReviewerAndAwaitVerdict(
 		return ReviewVerdict{}, fmt.Errorf("await review verdict for %s: %w", mission.ID, err)
 	}
 	llmCall.End(fmt.Sprintf("%s:%s", reviewerSession, verdict.Decision), nil, nil)
+	verdict.TranscriptRef = strings.TrimSpace(reviewerResult.TranscriptRef)
+	verdict.ReviewerSessionID = reviewerSession
 	return verdict, nil
 }
 
@@ -642,46 +2418,138 @@ func (c *Commander) handleReviewVerdict(
 	maxRevisions int,
 	verdict ReviewVerdict,
 ) (bool, error) {
+	if len(verdict.ACResults) > 0 {
+		c.progress.set(missionID, acCompletion(verdict.ACResults, mission.ACRefs))
+	}
 	switch verdict.Decision {
 	case protocol.ReviewVerdictApproved:
-		if err := c.publish(ctx, Event{
-			Type:      EventMissionCompleted,
-			MissionID: missionID,
-			WaveIndex: waveIndex,
-			Timestamp: c.now().UTC(),
-			Message:   "mission verified and reviewer approved",
-		}); err != nil {
-			return false, fmt.Errorf("publish completion event for %s: %w", missionID, err)
+		if err := c.completeReviewedMission(ctx, missionID, waveIndex, verdict, "mission verified and reviewer approved"); err != nil {
+			return false, err
+		}
+		return true, nil
+	case protocol.ReviewVerdictNeedsFixes:
+		return false, c.requestRevision(ctx, missionID, waveIndex, mission, maxRevisions, verdict.Feedback, verdict.AdditionalGates, "review requested fixes")
+	case protocol.ReviewVerdictEscalate:
+		return c.resolveReviewEscalation(ctx, missionID, waveIndex, mission, maxRevisions, verdict)
+	default:
+		_ = c.publishHalt(
+			ctx,
+			waveIndex,
+			missionID,
+			HaltReasonManualHalt,
+			fmt.Sprintf("unsupported reviewer verdict %q", verdict.Decision),
+		)
+		return false, fmt.Errorf("unsupported reviewer verdict %q for mission %s", verdict.Decision, missionID)
+	}
+}
+
+func (c *Commander) completeReviewedMission(
+	ctx context.Context,
+	missionID string,
+	waveIndex int,
+	verdict ReviewVerdict,
+	message string,
+) error {
+	if err := c.checkWorktreeCommitted(ctx, missionID, waveIndex); err != nil {
+		return err
+	}
+	if err := c.publish(ctx, Event{
+		Type:          EventMissionCompleted,
+		MissionID:     missionID,
+		WaveIndex:     waveIndex,
+		Timestamp:     c.now().UTC(),
+		Message:       message,
+		TranscriptRef: verdict.TranscriptRef,
+		ACResults:     verdict.ACResults,
+	}); err != nil {
+		return fmt.Errorf("publish completion event for %s: %w", missionID, err)
+	}
+	c.recordMissionOutcome(missionID, EventMissionCompleted)
+	c.completed.Store(missionID, struct{}{})
+	c.reportCompleted(missionID)
+	c.releaseMissionState(missionID)
+	return nil
+}
+
+// requestRevision bumps the revision count and stores feedback for the next implementer
+// dispatch, halting once the revision ceiling is reached. source prefixes the halt message.
+func (c *Commander) requestRevision(
+	ctx context.Context,
+	missionID string,
+	waveIndex int,
+	mission *Mission,
+	maxRevisions int,
+	feedback string,
+	gates []string,
+	source string,
+) error {
+	mission.RevisionCount++
+	mission.ReviewFeedback = strings.TrimSpace(feedback)
+	mission.AdditionalGates = gates
+	if value, ok := c.inFlight.Load(missionID); ok {
+		value.(*inFlightMission).revisions.Store(int64(mission.RevisionCount))
+	}
+	if mission.RevisionCount < maxRevisions {
+		return nil
+	}
+	invariants.CheckMaxRetriesNotExceeded(
+		ctx,
+		"commander.handleReviewVerdict",
+		mission.RevisionCount,
+		maxRevisions,
+	)
+	message := fmt.Sprintf(
+		"%s and revision count %d reached max revisions %d",
+		source,
+		mission.RevisionCount,
+		maxRevisions,
+	)
+	_ = c.publishHalt(ctx, waveIndex, missionID, HaltReasonMaxRevisionsExceeded, message)
+	return fmt.Errorf("mission %s halted after review: %s", missionID, message)
+}
+
+// resolveReviewEscalation pauses the mission for an Admiral decision on a reviewer ESCALATE
+// verdict: Approved completes it, Feedback sends it back for revision, Halted or Shelved halt it.
+func (c *Commander) resolveReviewEscalation(
+	ctx context.Context,
+	missionID string,
+	waveIndex int,
+	mission *Mission,
+	maxRevisions int,
+	verdict ReviewVerdict,
+) (bool, error) {
+	c.escalationMu.Lock()
+	response, err := c.approvalGate.AwaitDecision(ctx, buildEscalationRequest(c.currentCommissionID(), waveIndex, *mission, verdict))
+	c.escalationMu.Unlock()
+	if err != nil {
+		_ = c.publishHalt(ctx, waveIndex, missionID, HaltReasonManualHalt, fmt.Sprintf("await escalation decision: %v", err))
+		return false, fmt.Errorf("await escalation decision for %s: %w", missionID, err)
+	}
+
+	switch response.Decision {
+	case admiral.ApprovalDecisionApproved:
+		if err := c.completeReviewedMission(ctx, missionID, waveIndex, verdict, "Admiral approved mission after reviewer escalation"); err != nil {
+			return false, err
 		}
 		return true, nil
-	case protocol.ReviewVerdictNeedsFixes:
-		mission.RevisionCount++
-		mission.ReviewFeedback = strings.TrimSpace(verdict.Feedback)
-		if mission.RevisionCount >= maxRevisions {
-			invariants.CheckMaxRetriesNotExceeded(
-				ctx,
-				"commander.handleReviewVerdict",
-				mission.RevisionCount,
-				maxRevisions,
-			)
-			message := fmt.Sprintf(
-				"review requested fixes and revision count %d reached max revisions %d",
-				mission.RevisionCount,
-				maxRevisions,
-			)
-			_ = c.publishHalt(ctx, waveIndex, missionID, HaltReasonMaxRevisionsExceeded, message)
-			return false, fmt.Errorf("mission %s halted after review: %s", missionID, message)
+	case admiral.ApprovalDecisionFeedback:
+		return false, c.requestRevision(ctx, missionID, waveIndex, mission, maxRevisions, response.FeedbackText, nil, "Admiral requested fixes after escalation")
+	case admiral.ApprovalDecisionHalted, admiral.ApprovalDecisionShelved:
+		message := "Admiral halted mission after reviewer escalation"
+		if note := strings.TrimSpace(response.FeedbackText); note != "" {
+			message += ": " + note
 		}
-		return false, nil
+		_ = c.publishHalt(ctx, waveIndex, missionID, HaltReasonManualHalt, message)
+		return false, fmt.Errorf("mission %s halted: %s", missionID, message)
 	default:
 		_ = c.publishHalt(
 			ctx,
 			waveIndex,
 			missionID,
 			HaltReasonManualHalt,
-			fmt.Sprintf("unsupported reviewer verdict %q", verdict.Decision),
+			fmt.Sprintf("unsupported escalation decision %q", response.Decision),
 		)
-		return false, fmt.Errorf("unsupported reviewer verdict %q for mission %s", verdict.Decision, missionID)
+		return false, fmt.Errorf("unsupported escalation decision %q for mission %s", response.Decision, missionID)
 	}
 }
 
@@ -690,34 +2558,58 @@ func (c *Commander) runWaveReview(
 	commissionID string,
 	waveIndex int,
 	missions []Mission,
-) (string, error) {
-	demoTokens, err := c.collectWaveDemoTokens(missions)
+	halted []string,
+	nextWave []Mission,
+) (WaveReviewOutcome, error) {
+	succeeded := make([]Mission, 0, len(missions))
+	for _, mission := range missions {
+		if _, skipped := c.skipped.Load(mission.ID); skipped || containsString(halted, mission.ID) {
+			continue
+		}
+		succeeded = append(succeeded, mission)
+	}
+	demoTokens, err := c.collectWaveDemoTokens(succeeded)
 	if err != nil {
-		return "", fmt.Errorf("collect wave %d demo tokens: %w", waveIndex, err)
+		return WaveReviewOutcome{}, fmt.Errorf("collect wave %d demo tokens: %w", waveIndex, err)
 	}
 
-	response, err := c.approvalGate.AwaitDecision(ctx, buildWaveReviewRequest(commissionID, waveIndex, missions, demoTokens))
+	response, err := c.approvalGate.AwaitDecision(ctx, buildWaveReviewRequest(commissionID, waveIndex, succeeded, halted, demoTokens))
 	if err != nil {
-		return "", fmt.Errorf("await wave %d review decision: %w", waveIndex, err)
+		return WaveReviewOutcome{}, fmt.Errorf("await wave %d review decision: %w", waveIndex, err)
 	}
 
+	outcome := WaveReviewOutcome{
+		Decision: response.Decision,
+		Feedback: strings.TrimSpace(response.FeedbackText),
+	}
 	switch response.Decision {
 	case admiral.ApprovalDecisionApproved:
-		return "", nil
+		return outcome, nil
 	case admiral.ApprovalDecisionFeedback:
-		feedbackText := strings.TrimSpace(response.FeedbackText)
+		if err := c.recordWaveFeedback(ctx, commissionID, WaveFeedbackRecord{
+			WaveIndex:  waveIndex,
+			Feedback:   outcome.Feedback,
+			RecordedAt: c.now().UTC(),
+		}); err != nil {
+			return outcome, fmt.Errorf("record wave %d feedback: %w", waveIndex, err)
+		}
+		affected := make([]string, 0, len(nextWave))
+		for _, mission := range nextWave {
+			affected = append(affected, mission.ID)
+		}
 		if err := c.publish(ctx, Event{
-			Type:      EventWaveFeedbackRecorded,
-			WaveIndex: waveIndex,
-			Timestamp: c.now().UTC(),
-			Message:   feedbackText,
-			NotifyTUI: true,
+			Type:               EventWaveFeedbackRecorded,
+			WaveIndex:          waveIndex,
+			Timestamp:          c.now().UTC(),
+			Message:            outcome.Feedback,
+			NotifyTUI:          true,
+			AffectedMissionIDs: affected,
 		}); err != nil {
-			return "", fmt.Errorf("publish wave %d feedback: %w", waveIndex, err)
+			return outcome, fmt.Errorf("publish wave %d feedback: %w", waveIndex, err)
 		}
-		return feedbackText, nil
+		return outcome, nil
 	case admiral.ApprovalDecisionHalted, admiral.ApprovalDecisionShelved:
-		message := strings.TrimSpace(response.FeedbackText)
+		message := outcome.Feedback
 		if message == "" {
 			message = fmt.Sprintf("admiral halted execution after wave %d review", waveIndex)
 		}
@@ -728,11 +2620,11 @@ func (c *Commander) runWaveReview(
 			Message:   message,
 			NotifyTUI: true,
 		}); err != nil {
-			return "", fmt.Errorf("publish commission halt after wave %d review: %w", waveIndex, err)
+			return outcome, fmt.Errorf("publish commission halt after wave %d review: %w", waveIndex, err)
 		}
-		return "", fmt.Errorf("execution halted after wave %d review: %s", waveIndex, message)
+		return outcome, fmt.Errorf("execution halted after wave %d review: %s", waveIndex, message)
 	default:
-		return "", fmt.Errorf("unsupported wave review decision %q", response.Decision)
+		return outcome, fmt.Errorf("unsupported wave review decision %q", response.Decision)
 	}
 }
 
@@ -741,14 +2633,22 @@ func (c *Commander) collectWaveDemoTokens(missions []Mission) (map[string]string
 	for _, mission := range missions {
 		worktreePathRaw, ok := c.missionPaths.Load(mission.ID)
 		if !ok {
+			if _, completed := c.completed.Load(mission.ID); completed {
+				// Completed by a prior run; its demo token was reviewed then.
+				continue
+			}
 			return nil, fmt.Errorf("worktree path missing for mission %s", mission.ID)
 		}
 		worktreePath, ok := worktreePathRaw.(string)
 		if !ok || strings.TrimSpace(worktreePath) == "" {
 			return nil, fmt.Errorf("worktree path invalid for mission %s", mission.ID)
 		}
-		token, err := readDemoToken(worktreePath, mission.ID)
+		token, err := c.readDemoToken(worktreePath, mission.ID)
 		if err != nil {
+			if !c.requireTokens {
+				// Demo tokens are optional for this commission; review without one.
+				continue
+			}
 			return nil, fmt.Errorf("read demo token for mission %s: %w", mission.ID, err)
 		}
 		demoTokens[mission.ID] = token
@@ -772,10 +2672,13 @@ func (c *Commander) buildReviewerDispatchRequest(
 		return ReviewerDispatchRequest{}, fmt.Errorf("collect gate evidence: %w", err)
 	}
 
-	demoToken, err := readDemoToken(worktreePath, mission.ID)
+	demoToken, err := c.readDemoToken(worktreePath, mission.ID)
 	if err != nil {
 		demoToken = fmt.Sprintf("demo token unavailable: %v", err)
 	}
+	if c.redactTokens {
+		demoToken = telemetry.MaskSecrets(demoToken)
+	}
 
 	return ReviewerDispatchRequest{
 		Mission:                     mission,
@@ -783,13 +2686,101 @@ func (c *Commander) buildReviewerDispatchRequest(
 		CodeDiff:                    diff,
 		GateEvidence:                gateEvidence,
 		AcceptanceCriteria:          append([]string(nil), mission.AcceptanceCriteria...),
+		ACRefs:                      append([]string(nil), mission.ACRefs...),
 		DemoTokenContent:            demoToken,
 		ImplementerSessionID:        strings.TrimSpace(implementerSessionID),
 		ReadOnlyWorktree:            true,
-		IncludeImplementerReasoning: false,
+		IncludeImplementerReasoning: includeImplementerReasoning(mission),
+		RevisionDiffs:               c.revisionDiffHistory(ctx, mission, worktreePath),
 	}, nil
 }
 
+// revisionRefs records the worktree snapshot reviewed at each revision of one mission.
+type revisionRefs struct {
+	mu   sync.Mutex
+	refs map[int]string
+}
+
+// revisionDiffHistory snapshots the worktree for the mission's current revision and returns
+// the diffs between reviewed revisions, newest kept first when the budget runs out.
+func (c *Commander) revisionDiffHistory(ctx context.Context, mission Mission, worktreePath string) []RevisionDiff {
+	if c.revisionDiffs == RevisionDiffsOff {
+		return nil
+	}
+	entry, _ := c.revisionRefs.LoadOrStore(mission.ID, &revisionRefs{refs: make(map[int]string)})
+	history := entry.(*revisionRefs)
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	current := mission.RevisionCount
+	if _, ok := history.refs[current]; !ok {
+		ref, err := c.snapshot(ctx, worktreePath)
+		if err != nil {
+			c.logger.Printf("commander: revision %d snapshot for mission %s failed: %v", current, mission.ID, err)
+			return nil
+		}
+		history.refs[current] = ref
+	}
+
+	var steps [][2]int
+	switch c.revisionDiffs {
+	case RevisionDiffsCumulative:
+		first := current
+		for revision := range history.refs {
+			first = min(first, revision)
+		}
+		if first < current {
+			steps = append(steps, [2]int{first, current})
+		}
+	default:
+		for revision := current; revision > 0; revision-- {
+			if _, ok := history.refs[revision-1]; ok {
+				if _, ok := history.refs[revision]; ok {
+					steps = append(steps, [2]int{revision - 1, revision})
+				}
+			}
+		}
+	}
+
+	remaining := c.revDiffLimit
+	diffs := make([]RevisionDiff, 0, len(steps))
+	for _, step := range steps {
+		if remaining <= 0 {
+			break
+		}
+		diff, err := c.diffRefs(ctx, worktreePath, history.refs[step[0]], history.refs[step[1]])
+		if err != nil {
+			diff = fmt.Sprintf("diff unavailable: %v", err)
+		}
+		diff = truncateDiff(diff, remaining)
+		remaining -= len(diff)
+		diffs = append(diffs, RevisionDiff{FromRevision: step[0], ToRevision: step[1], Diff: diff})
+	}
+	slices.Reverse(diffs)
+	return diffs
+}
+
+// truncateDiff keeps the leading limit bytes of a diff, where file headers appear.
+func truncateDiff(diff string, limit int) string {
+	const marker = "\n... (truncated)"
+	if limit <= 0 || len(diff) <= limit {
+		return diff
+	}
+	if limit <= len(marker) {
+		return diff[:limit]
+	}
+	return diff[:limit-len(marker)] + marker
+}
+
+// includeImplementerReasoning requests reasoning capture only for RED_ALERT
+// missions whose harness can actually provide it.
+func includeImplementerReasoning(mission Mission) bool {
+	if !strings.EqualFold(strings.TrimSpace(mission.Classification), MissionClassificationREDAlert) {
+		return false
+	}
+	return harness.Capabilities(mission.Harness).ReasoningCapture
+}
+
 func (c *Commander) collectGateEvidence(ctx context.Context, missionID string) ([]string, error) {
 	if c.protocolStore == nil {
 		return []string{"gate evidence unavailable: protocol store not configured"}, nil
@@ -799,6 +2790,9 @@ func (c *Commander) collectGateEvidence(ctx context.Context, missionID string) (
 	if err != nil {
 		return nil, fmt.Errorf("list protocol events for mission %s: %w", missionID, err)
 	}
+	if c.evidenceScan > 0 && len(events) > c.evidenceScan {
+		events = events[len(events)-c.evidenceScan:]
+	}
 
 	gateEvidence := make([]string, 0, len(events))
 	for _, event := range events {
@@ -815,7 +2809,55 @@ func (c *Commander) collectGateEvidence(ctx context.Context, missionID string) (
 		return []string{"no gate evidence events recorded for mission"}, nil
 	}
 
-	return gateEvidence, nil
+	return budgetGateEvidence(gateEvidence, c.evidenceLimit), nil
+}
+
+// budgetGateEvidence keeps the most recent evidence entries within budget bytes
+// and replaces older entries with a single count summary.
+func budgetGateEvidence(entries []string, budget int) []string {
+	totalBytes := 0
+	for _, entry := range entries {
+		totalBytes += len(entry)
+	}
+	if budget <= 0 || totalBytes <= budget {
+		return entries
+	}
+
+	available := budget - len(gateEvidenceSummary(len(entries), totalBytes))
+	if available <= 0 {
+		return []string{gateEvidenceSummary(len(entries), totalBytes)}
+	}
+
+	kept := make([]string, 0, len(entries))
+	used := 0
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if used+len(entry) > available {
+			if len(kept) == 0 {
+				kept = append(kept, entry[:available])
+			}
+			break
+		}
+		kept = append(kept, entry)
+		used += len(entry)
+	}
+
+	omitted := len(entries) - len(kept)
+	omittedBytes := totalBytes
+	for _, entry := range kept {
+		omittedBytes -= len(entry)
+	}
+
+	out := make([]string, 0, len(kept)+1)
+	out = append(out, gateEvidenceSummary(omitted, omittedBytes))
+	for i := len(kept) - 1; i >= 0; i-- {
+		out = append(out, kept[i])
+	}
+	return out
+}
+
+func gateEvidenceSummary(omitted int, omittedBytes int) string {
+	return fmt.Sprintf("%d earlier gate evidence events omitted (%d bytes) to fit reviewer budget", omitted, omittedBytes)
 }
 
 func (c *Commander) awaitReviewVerdict(
@@ -864,7 +2906,7 @@ func (c *Commander) findReviewVerdict(
 	}
 
 	for i := len(events) - 1; i >= 0; i-- {
-		verdict, verdictImplementerSessionID, verdictReviewerSessionID, ok := parseReviewVerdict(events[i])
+		verdict, verdictImplementerSessionID, verdictReviewerSessionID, ok := parseReviewVerdict(events[i], c.verdictSchema)
 		if !ok {
 			continue
 		}
@@ -874,19 +2916,69 @@ func (c *Commander) findReviewVerdict(
 		if reviewerSessionID != "" && verdictReviewerSessionID != "" && verdictReviewerSessionID != reviewerSessionID {
 			continue
 		}
+		if _, pending := c.waitVerdicts[verdict]; pending {
+			// The reviewer's latest status is intermediate; keep polling for a terminal verdict.
+			return ReviewVerdict{}, false, nil
+		}
+		if len(c.waitVerdicts) == 0 && !isRecognizedReviewVerdict(verdict) {
+			// Without configured non-terminal verdicts, unknown statuses are skipped as before.
+			continue
+		}
 		return ReviewVerdict{
-			Decision: verdict,
-			Feedback: firstNonEmptyString(
-				extractJSONString(events[i].Payload, "feedback"),
-				extractJSONString(events[i].Payload, "feedback_text"),
-				extractJSONString(events[i].Payload, "feedbackText"),
-			),
+			Decision:        verdict,
+			Feedback:        extractJSONString(events[i].Payload, c.verdictSchema.feedbackKeys()...),
+			ACResults:       parseACResults(events[i].Payload),
+			AdditionalGates: parseAdditionalGates(events[i].Payload),
 		}, true, nil
 	}
 	return ReviewVerdict{}, false, nil
 }
 
-func parseReviewVerdict(event protocol.ProtocolEvent) (string, string, string, bool) {
+// VerdictSchema lists extra review_complete payload keys to consult after the built-in names.
+type VerdictSchema struct {
+	VerdictKeys            []string
+	FeedbackKeys           []string
+	ImplementerSessionKeys []string
+	ReviewerSessionKeys    []string
+}
+
+func (s VerdictSchema) validate() error {
+	fields := []struct {
+		name string
+		keys []string
+	}{
+		{"verdict", s.VerdictKeys},
+		{"feedback", s.FeedbackKeys},
+		{"implementer session", s.ImplementerSessionKeys},
+		{"reviewer session", s.ReviewerSessionKeys},
+	}
+	for _, field := range fields {
+		for _, key := range field.keys {
+			if strings.TrimSpace(key) == "" {
+				return fmt.Errorf("verdict schema %s keys must not be blank", field.name)
+			}
+		}
+	}
+	return nil
+}
+
+func (s VerdictSchema) verdictKeys() []string {
+	return append([]string{"verdict", "decision"}, s.VerdictKeys...)
+}
+
+func (s VerdictSchema) feedbackKeys() []string {
+	return append([]string{"feedback", "feedback_text", "feedbackText"}, s.FeedbackKeys...)
+}
+
+func (s VerdictSchema) implementerSessionKeys() []string {
+	return append([]string{"implementer_session_id", "implementerSessionID", "implementer_session"}, s.ImplementerSessionKeys...)
+}
+
+func (s VerdictSchema) reviewerSessionKeys() []string {
+	return append([]string{"reviewer_session_id", "reviewerSessionID", "reviewer_session"}, s.ReviewerSessionKeys...)
+}
+
+func parseReviewVerdict(event protocol.ProtocolEvent, schema VerdictSchema) (string, string, string, bool) {
 	if event.Type != protocol.EventTypeReviewComplete {
 		return "", "", "", false
 	}
@@ -896,17 +2988,111 @@ func parseReviewVerdict(event protocol.ProtocolEvent) (string, string, string, b
 		return "", "", "", false
 	}
 
-	verdict := strings.ToUpper(strings.TrimSpace(firstNonEmptyMap(payload, "verdict", "decision")))
-	if verdict != protocol.ReviewVerdictApproved && verdict != protocol.ReviewVerdictNeedsFixes {
+	// Unrecognized verdicts are returned so the commander can skip them, keep waiting on
+	// configured non-terminal values, or halt on them.
+	verdict := normalizeReviewVerdict(firstNonEmptyMap(payload, schema.verdictKeys()...))
+	if verdict == "" {
 		return "", "", "", false
 	}
 
 	return verdict,
-		strings.TrimSpace(firstNonEmptyMap(payload, "implementer_session_id", "implementerSessionID", "implementer_session")),
-		strings.TrimSpace(firstNonEmptyMap(payload, "reviewer_session_id", "reviewerSessionID", "reviewer_session")),
+		strings.TrimSpace(firstNonEmptyMap(payload, schema.implementerSessionKeys()...)),
+		strings.TrimSpace(firstNonEmptyMap(payload, schema.reviewerSessionKeys()...)),
 		true
 }
 
+// normalizeReviewVerdict upper-cases a verdict and maps spaces and hyphens to underscores so
+// values such as "needs-fixes" match protocol.ReviewVerdictNeedsFixes.
+func normalizeReviewVerdict(verdict string) string {
+	verdict = strings.ToUpper(strings.TrimSpace(verdict))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(verdict)
+}
+
+// isRecognizedReviewVerdict reports whether verdict is one of the terminal verdicts the
+// commander acts on.
+func isRecognizedReviewVerdict(verdict string) bool {
+	switch verdict {
+	case protocol.ReviewVerdictApproved, protocol.ReviewVerdictNeedsFixes, protocol.ReviewVerdictEscalate:
+		return true
+	default:
+		return false
+	}
+}
+
+func reviewVerdictSet(verdicts []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(verdicts))
+	for _, verdict := range verdicts {
+		verdict = normalizeReviewVerdict(verdict)
+		if verdict != "" {
+			set[verdict] = struct{}{}
+		}
+	}
+	return set
+}
+
+// parseACResults extracts reviewer per-AC results from a review payload's ac_results
+// (or acResults) array. Entries without an AC id are ignored.
+func parseACResults(raw json.RawMessage) []ACResult {
+	var payload struct {
+		ACResults      []ACResult `json:"ac_results"`
+		ACResultsCamel []ACResult `json:"acResults"`
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil
+	}
+	entries := payload.ACResults
+	if len(entries) == 0 {
+		entries = payload.ACResultsCamel
+	}
+
+	results := make([]ACResult, 0, len(entries))
+	for _, entry := range entries {
+		entry.ACID = strings.TrimSpace(entry.ACID)
+		if entry.ACID == "" {
+			continue
+		}
+		results = append(results, entry)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	return results
+}
+
+// parseAdditionalGates extracts reviewer-requested gates from a review payload's
+// additional_gates (or additionalGates) array, dropping blanks and duplicates.
+func parseAdditionalGates(raw json.RawMessage) []string {
+	var payload struct {
+		Gates      []string `json:"additional_gates"`
+		GatesCamel []string `json:"additionalGates"`
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil
+	}
+	entries := payload.Gates
+	if len(entries) == 0 {
+		entries = payload.GatesCamel
+	}
+
+	gates := make([]string, 0, len(entries))
+	seen := map[string]struct{}{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, ok := seen[entry]; ok {
+			continue
+		}
+		seen[entry] = struct{}{}
+		gates = append(gates, entry)
+	}
+	if len(gates) == 0 {
+		return nil
+	}
+	return gates
+}
+
 func firstNonEmptyMap(values map[string]any, keys ...string) string {
 	for _, key := range keys {
 		raw, ok := values[key]
@@ -933,18 +3119,80 @@ func extractJSONString(raw json.RawMessage, keys ...string) string {
 	return firstNonEmptyMap(payload, keys...)
 }
 
-func firstNonEmptyString(values ...string) string {
-	for _, value := range values {
-		value = strings.TrimSpace(value)
-		if value != "" {
-			return value
+func gitDiff(ctx context.Context, worktreePath string) (string, error) {
+	out, err := exec.CommandContext(ctx, "git", "-C", worktreePath, "diff", "--").CombinedOutput()
+	if err != nil {
+		trimmed := strings.TrimSpace(string(out))
+		if trimmed == "" {
+			return "", fmt.Errorf("git diff: %w", err)
 		}
+		return "", fmt.Errorf("git diff: %w (%s)", err, trimmed)
 	}
-	return ""
+	return string(out), nil
 }
 
-func gitDiff(ctx context.Context, worktreePath string) (string, error) {
-	out, err := exec.CommandContext(ctx, "git", "-C", worktreePath, "diff", "--").CombinedOutput()
+// gitSnapshot records the worktree's current state, untracked files included, as a tree
+// object. It stages into a throwaway copy of the index so the worktree's own index, branch,
+// and files are left untouched; ignored files stay out of the snapshot.
+func gitSnapshot(ctx context.Context, worktreePath string) (string, error) {
+	indexPath, err := runGitSnapshotStep(ctx, worktreePath, nil, "rev-parse", "--path-format=absolute", "--git-path", "index")
+	if err != nil {
+		return "", err
+	}
+	scratch, err := os.CreateTemp("", "sc3-snapshot-index-*")
+	if err != nil {
+		return "", fmt.Errorf("create snapshot index: %w", err)
+	}
+	scratchPath := scratch.Name()
+	defer func() {
+		_ = os.Remove(scratchPath)
+	}()
+	// Seeding from the real index keeps its stat cache, so unchanged files are not rehashed.
+	// #nosec G304 -- indexPath is reported by git for the mission worktree.
+	index, readErr := os.ReadFile(indexPath)
+	if readErr == nil {
+		_, err = scratch.Write(index)
+	}
+	if closeErr := scratch.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("write snapshot index: %w", err)
+	}
+
+	env := []string{"GIT_INDEX_FILE=" + scratchPath}
+	if readErr != nil {
+		if !errors.Is(readErr, os.ErrNotExist) {
+			return "", fmt.Errorf("read worktree index: %w", readErr)
+		}
+		if _, err := runGitSnapshotStep(ctx, worktreePath, env, "read-tree", "HEAD"); err != nil {
+			return "", err
+		}
+	}
+	if _, err := runGitSnapshotStep(ctx, worktreePath, env, "add", "-A"); err != nil {
+		return "", err
+	}
+	return runGitSnapshotStep(ctx, worktreePath, env, "write-tree")
+}
+
+func runGitSnapshotStep(ctx context.Context, worktreePath string, env []string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", worktreePath}, args...)...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	out, err := cmd.CombinedOutput()
+	trimmed := strings.TrimSpace(string(out))
+	if err != nil {
+		if trimmed == "" {
+			return "", fmt.Errorf("git %s: %w", args[0], err)
+		}
+		return "", fmt.Errorf("git %s: %w (%s)", args[0], err, trimmed)
+	}
+	return trimmed, nil
+}
+
+func gitDiffRefs(ctx context.Context, worktreePath, from, to string) (string, error) {
+	out, err := exec.CommandContext(ctx, "git", "-C", worktreePath, "diff", from, to, "--").CombinedOutput()
 	if err != nil {
 		trimmed := strings.TrimSpace(string(out))
 		if trimmed == "" {
@@ -979,15 +3227,42 @@ func looksLikePatchFailure(err error) bool {
 		strings.Contains(text, "reject")
 }
 
-func readDemoToken(worktreePath string, missionID string) (string, error) {
-	root := filepath.Clean(worktreePath)
-	if root == "." || root == "" {
-		return "", errors.New("worktree path must not be empty")
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+func gitLastCommitTime(ctx context.Context, runner CommandRunner, worktreePath string) (time.Time, error) {
+	stdout, stderr, err := runner.Run(ctx, worktreePath, "git", "log", "-1", "--format=%ct")
+	trimmed := strings.TrimSpace(string(stdout))
+	if err != nil {
+		if detail := strings.TrimSpace(string(stderr)); detail != "" {
+			return time.Time{}, fmt.Errorf("git log: %w (%s)", err, detail)
+		}
+		return time.Time{}, fmt.Errorf("git log: %w", err)
+	}
+	seconds, err := strconv.ParseInt(trimmed, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse git commit time %q: %w", trimmed, err)
 	}
-	tokenPath := filepath.Clean(filepath.Join(root, "demo", fmt.Sprintf("MISSION-%s.md", missionID)))
-	rootWithSep := root + string(os.PathSeparator)
-	if tokenPath != root && !strings.HasPrefix(tokenPath, rootWithSep) {
-		return "", fmt.Errorf("demo token path escapes worktree root: %s", tokenPath)
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// demoTokenPath resolves the mission's demo token to the first configured candidate path
+// that exists, keeping every candidate inside the worktree.
+func (c *Commander) demoTokenPath(worktreePath string, missionID string) (string, error) {
+	return demo.ResolveTokenPath(worktreePath, missionID, c.tokenPaths)
+}
+
+func (c *Commander) readDemoToken(worktreePath string, missionID string) (string, error) {
+	tokenPath, err := c.demoTokenPath(worktreePath, missionID)
+	if err != nil {
+		return "", err
 	}
 	// #nosec G304 -- tokenPath is constrained to worktree root and deterministic mission filename.
 	content, err := os.ReadFile(tokenPath)
@@ -1004,6 +3279,13 @@ func pickDuration(value time.Duration, fallback time.Duration) time.Duration {
 	return fallback
 }
 
+func pickInt(value int, fallback int) int {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
+
 func (c *Commander) publishHalt(
 	ctx context.Context,
 	waveIndex int,
@@ -1011,6 +3293,15 @@ func (c *Commander) publishHalt(
 	reason HaltReason,
 	message string,
 ) error {
+	if c.missionCancelled(missionID) {
+		// Failures caused by an operator cancel are already reported as "operator cancelled".
+		return nil
+	}
+	if errors.Is(context.Cause(ctx), ErrMissionTimeout) {
+		// runMission reports timed-out missions with HaltReasonMissionTimeout.
+		return nil
+	}
+	c.markMissionHalted(missionID)
 	return c.publish(ctx, Event{
 		Type:      EventMissionHalted,
 		MissionID: missionID,
@@ -1022,8 +3313,104 @@ func (c *Commander) publishHalt(
 	})
 }
 
+// recordWaveFeedback adds wave feedback to the execution report and the configured store.
+func (c *Commander) recordWaveFeedback(ctx context.Context, commissionID string, record WaveFeedbackRecord) error {
+	c.reportMu.Lock()
+	c.report.WaveFeedback = append(c.report.WaveFeedback, record)
+	c.reportMu.Unlock()
+
+	if c.feedbackLog == nil {
+		return nil
+	}
+	return c.feedbackLog.RecordWaveFeedback(ctx, commissionID, record)
+}
+
+// Report returns a summary of the most recent Execute run, including recorded wave feedback.
+// Missions completed or skipped by earlier runs of this commander are not included.
+func (c *Commander) Report() ExecutionReport {
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+
+	report := ExecutionReport{
+		CommissionID:        c.report.CommissionID,
+		CompletedMissionIDs: append([]string(nil), c.report.CompletedMissionIDs...),
+		SkippedMissionIDs:   append([]string(nil), c.report.SkippedMissionIDs...),
+		WaveFeedback:        append([]WaveFeedbackRecord(nil), c.report.WaveFeedback...),
+	}
+	sort.Strings(report.CompletedMissionIDs)
+	sort.Strings(report.SkippedMissionIDs)
+	return report
+}
+
+// reportCompleted adds missionID to the current run's completed missions.
+func (c *Commander) reportCompleted(missionID string) {
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+	if !containsString(c.report.CompletedMissionIDs, missionID) {
+		c.report.CompletedMissionIDs = append(c.report.CompletedMissionIDs, missionID)
+	}
+}
+
+// reportSkipped adds missionID to the current run's skipped missions.
+func (c *Commander) reportSkipped(missionID string) {
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+	if !containsString(c.report.SkippedMissionIDs, missionID) {
+		c.report.SkippedMissionIDs = append(c.report.SkippedMissionIDs, missionID)
+	}
+}
+
+// RecentEvents returns up to n of the most recently published events, oldest first.
+// A non-positive n returns every retained event.
+func (c *Commander) RecentEvents(n int) []Event {
+	if c.eventLog == nil {
+		return nil
+	}
+	return c.eventLog.recent(n)
+}
+
+// WaveProgress returns the wave's completion fraction (0-1): the average of its missions'
+// progress, derived from reviewer AC results, harness progress, and completions.
+func (c *Commander) WaveProgress(waveIndex int) float64 {
+	if c == nil {
+		return 0
+	}
+	return c.progress.wave(waveIndex)
+}
+
+// flushEvents drains a buffering publisher. It ignores ctx cancellation so events recorded
+// before an aborted run are still delivered.
+func (c *Commander) flushEvents(ctx context.Context) error {
+	var err error
+	if flusher, ok := c.events.(eventFlusher); ok {
+		err = flusher.Flush(context.WithoutCancel(ctx))
+	}
+	if flusher, ok := c.notifySink.(eventFlusher); ok {
+		// Notification delivery is best-effort, matching publish.
+		_ = flusher.Flush(context.WithoutCancel(ctx))
+	}
+	return err
+}
+
 func (c *Commander) publish(ctx context.Context, event Event) error {
-	return c.events.Publish(ctx, event)
+	// Sequence assignment and ring insertion share a lock so RecentEvents stays in Seq order.
+	c.eventSeqMu.Lock()
+	c.eventSeq++
+	event.Seq = c.eventSeq
+	if c.eventLog != nil {
+		c.eventLog.add(event)
+	}
+	c.eventSeqMu.Unlock()
+	if err := c.events.Publish(ctx, event); err != nil {
+		return err
+	}
+	c.recordExecution(ctx, event)
+	c.progress.track(event)
+	if event.NotifyTUI && c.notifySink != nil {
+		// Notification delivery is best-effort and must not change mission outcomes.
+		_ = c.notifySink.Notify(ctx, event)
+	}
+	return nil
 }
 
 func haltBeforeDispatch(mission Mission) (HaltReason, string, bool) {
@@ -1065,6 +3452,17 @@ func classifyDemoTokenHaltReason(err error) HaltReason {
 	return HaltReasonDemoTokenInvalid
 }
 
+// missionSessionName derives a predictable harness session name for a mission.
+// Revisions append the attempt number so redispatches never collide with the
+// session of an earlier attempt.
+func missionSessionName(mission Mission) string {
+	name := fmt.Sprintf("MISSION-%s", strings.TrimSpace(mission.ID))
+	if mission.RevisionCount > 0 {
+		return fmt.Sprintf("%s-attempt-%d", name, mission.RevisionCount+1)
+	}
+	return name
+}
+
 func isStandardOpsMission(mission Mission) bool {
 	return strings.EqualFold(strings.TrimSpace(mission.Classification), MissionClassificationStandardOps)
 }
@@ -1129,17 +3527,7 @@ func buildApprovalRequest(
 	requestMissions := make([]admiral.Mission, 0, len(manifest))
 	coverage := make(map[string]admiral.CoverageStatus)
 	for _, mission := range manifest {
-		requestMissions = append(requestMissions, admiral.Mission{
-			ID:                        mission.ID,
-			Title:                     mission.Title,
-			DependsOn:                 append([]string(nil), mission.DependsOn...),
-			UseCaseIDs:                append([]string(nil), mission.UseCaseIDs...),
-			Classification:            mission.Classification,
-			ClassificationRationale:   mission.ClassificationRationale,
-			ClassificationCriteria:    append([]string(nil), mission.ClassificationCriteria...),
-			ClassificationConfidence:  mission.ClassificationConfidence,
-			ClassificationNeedsReview: mission.ClassificationNeedsReview,
-		})
+		requestMissions = append(requestMissions, toAdmiralMission(mission))
 		for _, useCaseID := range mission.UseCaseIDs {
 			useCaseID = strings.TrimSpace(useCaseID)
 			if useCaseID == "" {
@@ -1171,26 +3559,55 @@ func buildApprovalRequest(
 	}
 }
 
+// toAdmiralMission converts a mission into the Admiral summary, carrying the full
+// classification criteria and confidence so every approval surface can explain it.
+func toAdmiralMission(mission Mission) admiral.Mission {
+	return admiral.Mission{
+		ID:                         mission.ID,
+		Title:                      mission.Title,
+		DependsOn:                  append([]string(nil), mission.DependsOn...),
+		UseCaseIDs:                 append([]string(nil), mission.UseCaseIDs...),
+		Classification:             mission.Classification,
+		ClassificationRationale:    mission.ClassificationRationale,
+		ClassificationCriteria:     append([]string(nil), mission.ClassificationCriteria...),
+		ClassificationConfidence:   mission.ClassificationConfidence,
+		ClassificationNeedsReview:  mission.ClassificationNeedsReview,
+		ClassificationReviewSource: mission.ClassificationReviewSource,
+	}
+}
+
+func buildEscalationRequest(commissionID string, waveIndex int, mission Mission, verdict ReviewVerdict) admiral.ApprovalRequest {
+	return admiral.ApprovalRequest{
+		CommissionID:    commissionID,
+		MissionManifest: []admiral.Mission{toAdmiralMission(mission)},
+		WaveAssignments: []admiral.Wave{{
+			Index:      waveIndex,
+			MissionIDs: []string{mission.ID},
+		}},
+		CoverageMap:   map[string]admiral.CoverageStatus{},
+		Iteration:     1,
+		MaxIterations: 1,
+		Escalation: &admiral.ReviewEscalation{
+			MissionID:         mission.ID,
+			WaveIndex:         waveIndex,
+			RevisionCount:     mission.RevisionCount,
+			ReviewerSessionID: verdict.ReviewerSessionID,
+			Reason:            strings.TrimSpace(verdict.Feedback),
+		},
+	}
+}
+
 func buildWaveReviewRequest(
 	commissionID string,
 	waveIndex int,
 	missions []Mission,
+	halted []string,
 	demoTokens map[string]string,
 ) admiral.ApprovalRequest {
 	requestMissions := make([]admiral.Mission, 0, len(missions))
 	missionIDs := make([]string, 0, len(missions))
 	for _, mission := range missions {
-		requestMissions = append(requestMissions, admiral.Mission{
-			ID:                        mission.ID,
-			Title:                     mission.Title,
-			DependsOn:                 append([]string(nil), mission.DependsOn...),
-			UseCaseIDs:                append([]string(nil), mission.UseCaseIDs...),
-			Classification:            mission.Classification,
-			ClassificationRationale:   mission.ClassificationRationale,
-			ClassificationCriteria:    append([]string(nil), mission.ClassificationCriteria...),
-			ClassificationConfidence:  mission.ClassificationConfidence,
-			ClassificationNeedsReview: mission.ClassificationNeedsReview,
-		})
+		requestMissions = append(requestMissions, toAdmiralMission(mission))
 		missionIDs = append(missionIDs, mission.ID)
 	}
 
@@ -1205,8 +3622,10 @@ func buildWaveReviewRequest(
 		Iteration:     1,
 		MaxIterations: 1,
 		WaveReview: &admiral.WaveReview{
-			WaveIndex:  waveIndex,
-			DemoTokens: demoTokens,
+			WaveIndex:      waveIndex,
+			DemoTokens:     demoTokens,
+			DemoTokenOrder: admiral.SortedDemoTokenIDs(demoTokens),
+			HaltedMissions: append([]string(nil), halted...),
 		},
 	}
 }
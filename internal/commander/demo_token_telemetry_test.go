@@ -0,0 +1,86 @@
+package commander
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestValidateDemoTokenRecordsSizeAndLatencySpan(t *testing.T) {
+	recorder := installClassificationSpanRecorder(t)
+
+	worktree := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(worktree, "demo"), 0o750); err != nil {
+		t.Fatalf("create demo dir: %v", err)
+	}
+	token := "# MISSION-m1 demo evidence\n"
+	if err := os.WriteFile(filepath.Join(worktree, "demo", "MISSION-m1.md"), []byte(token), 0o600); err != nil {
+		t.Fatalf("write demo token: %v", err)
+	}
+
+	cmd := &Commander{
+		demoTokens: &fakeDemoTokenValidator{},
+		logger:     &fakeLogger{},
+		now:        time.Now,
+	}
+	if err := cmd.validateDemoToken(context.Background(), Mission{ID: "m1"}, worktree); err != nil {
+		t.Fatalf("validate demo token: %v", err)
+	}
+
+	span := findDemoTokenValidationSpan(t, recorder.Ended())
+	if got := getClassificationIntAttr(span.Attributes(), "demo_token_bytes"); got != len(token) {
+		t.Fatalf("demo_token_bytes = %d, want %d", got, len(token))
+	}
+	if got := getClassificationIntAttr(span.Attributes(), "validation_ms"); got < 0 {
+		t.Fatalf("validation_ms = %d, want >= 0", got)
+	}
+	if got := getClassificationStringAttr(span.Attributes(), "mission_id"); got != "m1" {
+		t.Fatalf("mission_id = %q, want m1", got)
+	}
+	if got := getClassificationStringAttr(span.Attributes(), "outcome"); got != "valid" {
+		t.Fatalf("outcome = %q, want valid", got)
+	}
+	for _, attr := range span.Attributes() {
+		if strings.Contains(attr.Value.Emit(), "demo evidence") {
+			t.Fatalf("attribute %s exports demo token content", attr.Key)
+		}
+	}
+}
+
+func TestValidateDemoTokenSpanOmitsSizeForMissingToken(t *testing.T) {
+	recorder := installClassificationSpanRecorder(t)
+
+	cmd := &Commander{
+		demoTokens: &fakeDemoTokenValidator{err: os.ErrNotExist},
+		logger:     &fakeLogger{},
+		now:        time.Now,
+	}
+	err := cmd.validateDemoToken(context.Background(), Mission{ID: "m1"}, t.TempDir())
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("validate demo token error = %v, want os.ErrNotExist", err)
+	}
+
+	span := findDemoTokenValidationSpan(t, recorder.Ended())
+	for _, attr := range span.Attributes() {
+		if attr.Key == "demo_token_bytes" {
+			t.Fatalf("demo_token_bytes = %d, want attribute omitted", attr.Value.AsInt64())
+		}
+	}
+}
+
+func findDemoTokenValidationSpan(t *testing.T, spans []sdktrace.ReadOnlySpan) sdktrace.ReadOnlySpan {
+	t.Helper()
+	for _, span := range spans {
+		if span.Name() == "commander.demo_token_validation" {
+			return span
+		}
+	}
+	t.Fatalf("commander.demo_token_validation span not found in %d spans", len(spans))
+	return nil
+}
@@ -2,9 +2,12 @@ package commander
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/otel/codes"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -46,6 +49,45 @@ func TestDispatchImplementerEmitsLLMCallSpan(t *testing.T) {
 	}
 }
 
+func TestDispatchImplementerRecordsRetryAttemptsOnLLMCallSpan(t *testing.T) {
+	recorder := installClassificationSpanRecorder(t)
+	transient := &RetriableError{Err: errors.New("tmux: resource temporarily unavailable")}
+	harness := &fakeHarness{dispatchErrs: []error{transient, transient}}
+	cmd := &Commander{
+		harness:       harness,
+		events:        &fakeEventPublisher{},
+		logger:        &fakeLogger{},
+		now:           time.Now,
+		dispatchRetry: DispatchRetry{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+
+	result, err := cmd.dispatchImplementer(context.Background(), Mission{ID: "m1", Title: "Mission One"}, t.TempDir(), 1)
+	if err != nil {
+		t.Fatalf("dispatch implementer: %v", err)
+	}
+	if result.SessionID != "session-m1" {
+		t.Fatalf("session id = %q, want session-m1", result.SessionID)
+	}
+	if len(harness.implementerDispatches) != 3 {
+		t.Fatalf("implementer dispatches = %d, want 3", len(harness.implementerDispatches))
+	}
+
+	span := findDispatchSpanByOperation(t, recorder.Ended(), "dispatch_implementer")
+	var attempts []int64
+	for _, event := range span.Events() {
+		if event.Name != "llm.error" || getClassificationStringAttr(event.Attributes, "error_type") != "implementer_dispatch_retry" {
+			continue
+		}
+		attempts = append(attempts, int64(getClassificationIntAttr(event.Attributes, "retry_count")))
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Fatalf("retry attempts = %v, want [1 2]", attempts)
+	}
+	if span.Status().Code != codes.Ok {
+		t.Fatalf("span status = %v, want Ok after successful retry", span.Status().Code)
+	}
+}
+
 func TestDispatchReviewerAndAwaitVerdictEmitsLLMCallSpan(t *testing.T) {
 	recorder := installClassificationSpanRecorder(t)
 	harness := &fakeHarness{reviewerSessionIDs: []string{"rev-42"}}
@@ -70,6 +112,7 @@ func TestDispatchReviewerAndAwaitVerdictEmitsLLMCallSpan(t *testing.T) {
 		t.TempDir(),
 		1,
 		"impl-21",
+		nil,
 	)
 	if err != nil {
 		t.Fatalf("dispatch reviewer: %v", err)
@@ -103,3 +146,53 @@ func findDispatchSpanByOperation(t *testing.T, spans []sdktrace.ReadOnlySpan, op
 	t.Fatalf("llm.call span with operation %q not found in %d spans", operation, len(spans))
 	return nil
 }
+
+func TestRunMissionRecordsSurfaceLockHoldTime(t *testing.T) {
+	recorder := installClassificationSpanRecorder(t)
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		CommanderConfig{WIPLimit: 1},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+	var clockMu sync.Mutex
+	clock := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	cmd.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		clock = clock.Add(250 * time.Millisecond)
+		return clock
+	}
+
+	mission := Mission{ID: "m1", Title: "Mission One", SurfaceArea: []string{"internal/commander/**"}}
+	if err := cmd.runMission(context.Background(), 1, mission); err != nil {
+		t.Fatalf("run mission: %v", err)
+	}
+
+	var lockSpan sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "commander.surface_lock" {
+			lockSpan = span
+			break
+		}
+	}
+	if lockSpan == nil {
+		t.Fatal("commander.surface_lock span not recorded")
+	}
+	if got := getClassificationStringAttr(lockSpan.Attributes(), "mission_id"); got != "m1" {
+		t.Fatalf("mission_id = %q, want m1", got)
+	}
+	if got := getClassificationIntAttr(lockSpan.Attributes(), "lock_hold_ms"); got <= 0 {
+		t.Fatalf("lock_hold_ms = %d, want > 0", got)
+	}
+	if got := lockSpan.EndTime().Sub(lockSpan.StartTime()); got <= 0 {
+		t.Fatalf("lock span duration = %s, want > 0", got)
+	}
+}
@@ -0,0 +1,129 @@
+package commander
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchEventPublisher publishes several events in one backend call, in order.
+type BatchEventPublisher interface {
+	PublishBatch(ctx context.Context, events []Event) error
+}
+
+// BatchingPublisher buffers commander events and forwards them to the wrapped publisher in
+// batches. A batch flushes once it reaches the size limit, once its oldest event has waited
+// the flush interval, or on an explicit Flush.
+type BatchingPublisher struct {
+	target   EventPublisher
+	maxBatch int
+	interval time.Duration
+
+	mu         sync.Mutex
+	pending    []Event
+	timer      *time.Timer
+	generation uint64
+	onError    func(error)
+}
+
+// NewBatchingPublisher wraps target so events are delivered in batches of up to maxBatch.
+// Targets implementing BatchEventPublisher receive one call per batch; others receive the
+// buffered events one by one, still in publish order. A non-positive interval disables
+// time-based flushing.
+func NewBatchingPublisher(target EventPublisher, maxBatch int, interval time.Duration) (*BatchingPublisher, error) {
+	if target == nil {
+		return nil, errors.New("event publisher is required")
+	}
+	if maxBatch <= 0 {
+		return nil, errors.New("batch size must be positive")
+	}
+	return &BatchingPublisher{
+		target:   target,
+		maxBatch: maxBatch,
+		interval: interval,
+	}, nil
+}
+
+// SetErrorHandler receives delivery errors from interval flushes, which have no caller to
+// return them to. Without a handler those errors are dropped along with their batch.
+func (p *BatchingPublisher) SetErrorHandler(handler func(error)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onError = handler
+}
+
+// Publish buffers event and flushes the batch when it reaches the size limit. The returned
+// error only ever describes the batch containing event.
+func (p *BatchingPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pending = append(p.pending, event)
+	if len(p.pending) >= p.maxBatch {
+		return p.flushLocked(ctx)
+	}
+	if p.interval > 0 && p.timer == nil {
+		generation := p.generation
+		p.timer = time.AfterFunc(p.interval, func() { p.flushOnInterval(generation) })
+	}
+	return nil
+}
+
+// Flush delivers every buffered event.
+func (p *BatchingPublisher) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.flushLocked(ctx)
+}
+
+// flushOnInterval delivers a batch whose oldest event waited the flush interval, unless the
+// batch it was scheduled for was already flushed.
+func (p *BatchingPublisher) flushOnInterval(generation uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if generation != p.generation {
+		return
+	}
+	if err := p.flushLocked(context.Background()); err != nil && p.onError != nil {
+		p.onError(err)
+	}
+}
+
+// flushLocked delivers the buffer under the lock so concurrent publishers and the interval
+// timer cannot reorder batches. A batch that fails to deliver is dropped rather than retried
+// so its error is never reported against a later batch.
+func (p *BatchingPublisher) flushLocked(ctx context.Context) error {
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.generation++
+	batch := p.pending
+	p.pending = nil
+	if len(batch) == 0 {
+		return nil
+	}
+	if batcher, ok := p.target.(BatchEventPublisher); ok {
+		if err := batcher.PublishBatch(ctx, batch); err != nil {
+			return fmt.Errorf("publish batch of %d events: %w", len(batch), err)
+		}
+		return nil
+	}
+	for i, event := range batch {
+		if err := p.target.Publish(ctx, event); err != nil {
+			return fmt.Errorf("publish batch of %d events: %d undelivered: %w", len(batch), len(batch)-i, err)
+		}
+	}
+	return nil
+}
+
+// eventFlusher is implemented by publishers that buffer events, such as BatchingPublisher.
+type eventFlusher interface {
+	Flush(ctx context.Context) error
+}
+
+var _ EventPublisher = (*BatchingPublisher)(nil)
@@ -0,0 +1,173 @@
+package commander
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeBatchPublisher struct {
+	mu      sync.Mutex
+	batches [][]Event
+	// failures is how many upcoming PublishBatch calls fail.
+	failures int
+}
+
+func (f *fakeBatchPublisher) Publish(ctx context.Context, event Event) error {
+	return f.PublishBatch(ctx, []Event{event})
+}
+
+func (f *fakeBatchPublisher) PublishBatch(_ context.Context, events []Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("backend unavailable")
+	}
+	f.batches = append(f.batches, append([]Event(nil), events...))
+	return nil
+}
+
+func (f *fakeBatchPublisher) Batches() [][]Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([][]Event(nil), f.batches...)
+}
+
+func TestBatchingPublisherFlushesOnSizeAndPreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	target := &fakeBatchPublisher{}
+	publisher, err := NewBatchingPublisher(target, 2, 0)
+	if err != nil {
+		t.Fatalf("new batching publisher: %v", err)
+	}
+	for _, id := range []string{"m1", "m2", "m3"} {
+		if err := publisher.Publish(context.Background(), Event{Type: EventMissionCompleted, MissionID: id}); err != nil {
+			t.Fatalf("publish %s: %v", id, err)
+		}
+	}
+	if len(target.batches) != 1 || len(target.batches[0]) != 2 {
+		t.Fatalf("batches before flush = %+v, want one batch of two", target.batches)
+	}
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	got := []string{}
+	for _, batch := range target.batches {
+		for _, event := range batch {
+			got = append(got, event.MissionID)
+		}
+	}
+	if want := []string{"m1", "m2", "m3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("published missions = %v, want %v", got, want)
+	}
+}
+
+func TestBatchingPublisherFlushesOnIntervalWithoutFurtherEvents(t *testing.T) {
+	t.Parallel()
+
+	target := &fakeBatchPublisher{}
+	publisher, err := NewBatchingPublisher(target, 10, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new batching publisher: %v", err)
+	}
+	if err := publisher.Publish(context.Background(), Event{Type: EventMissionCompleted, MissionID: "m1"}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for len(target.Batches()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("buffered event was not flushed after the interval elapsed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if batches := target.Batches(); len(batches) != 1 || len(batches[0]) != 1 || batches[0][0].MissionID != "m1" {
+		t.Fatalf("batches = %+v, want one interval batch with m1", batches)
+	}
+}
+
+func TestBatchingPublisherReportsFlushErrorsForTheirOwnBatch(t *testing.T) {
+	t.Parallel()
+
+	target := &fakeBatchPublisher{failures: 2}
+	publisher, err := NewBatchingPublisher(target, 2, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new batching publisher: %v", err)
+	}
+	intervalErrs := make(chan error, 1)
+	publisher.SetErrorHandler(func(err error) { intervalErrs <- err })
+
+	if err := publisher.Publish(context.Background(), Event{MissionID: "m1"}); err != nil {
+		t.Fatalf("publish m1: %v", err)
+	}
+	if err := publisher.Publish(context.Background(), Event{MissionID: "m2"}); err == nil || !strings.Contains(err.Error(), "batch of 2 events") {
+		t.Fatalf("publish m2 error = %v, want the failed batch of 2", err)
+	}
+
+	if err := publisher.Publish(context.Background(), Event{MissionID: "m3"}); err != nil {
+		t.Fatalf("publish m3 error = %v, want nil: the earlier failure belongs to another batch", err)
+	}
+	select {
+	case err := <-intervalErrs:
+		if !strings.Contains(err.Error(), "batch of 1 events") {
+			t.Fatalf("interval error = %v, want the failed batch of 1", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("interval flush error was not reported")
+	}
+
+	if err := publisher.Publish(context.Background(), Event{MissionID: "m4"}); err != nil {
+		t.Fatalf("publish m4: %v", err)
+	}
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatalf("flush error = %v, want nil after earlier batches failed", err)
+	}
+	if batches := target.Batches(); len(batches) != 1 || len(batches[0]) != 1 || batches[0][0].MissionID != "m4" {
+		t.Fatalf("delivered batches = %+v, want only m4", batches)
+	}
+}
+
+func TestCommanderExecuteFlushesBatchedEventsOnCompletion(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeManifestStore{
+		manifest: []Mission{
+			{ID: "m1", Title: "Docs", Classification: MissionClassificationStandardOps},
+			{ID: "m2", Title: "Lint", Classification: MissionClassificationStandardOps},
+		},
+		ready: [][]string{{"m1", "m2"}},
+	}
+	target := &fakeBatchPublisher{}
+
+	cmd, err := newCommanderForTest(
+		store,
+		&fakeWorktreeManager{paths: map[string]string{"m1": "/tmp/worktree/m1", "m2": "/tmp/worktree/m2"}},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		target,
+		CommanderConfig{WIPLimit: 2, EventBatchSize: 100},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	if err := cmd.Execute(context.Background(), "commission-1"); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	if len(target.batches) != 1 {
+		t.Fatalf("batches = %d, want all events coalesced into one batch", len(target.batches))
+	}
+	if want := cmd.RecentEvents(0); len(want) < 2 || !reflect.DeepEqual(target.batches[0], want) {
+		t.Fatalf("flushed batch = %+v, want every published event in order %+v", target.batches[0], want)
+	}
+}
@@ -0,0 +1,48 @@
+package commander
+
+import "sync"
+
+// eventRing retains the most recent commander events in a fixed-size buffer.
+type eventRing struct {
+	mu     sync.Mutex
+	events []Event
+	next   int
+	size   int
+}
+
+func newEventRing(capacity int) *eventRing {
+	return &eventRing{events: make([]Event, capacity)}
+}
+
+func (r *eventRing) add(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.events) == 0 {
+		return
+	}
+	r.events[r.next] = event
+	r.next = (r.next + 1) % len(r.events)
+	if r.size < len(r.events) {
+		r.size++
+	}
+}
+
+// recent returns up to n events oldest-first; n <= 0 returns every retained event.
+func (r *eventRing) recent(n int) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n <= 0 || n > r.size {
+		n = r.size
+	}
+	out := make([]Event, 0, n)
+	if n == 0 {
+		return out
+	}
+	start := (r.next - n + len(r.events)) % len(r.events)
+	for i := 0; i < n; i++ {
+		out = append(out, r.events[(start+i)%len(r.events)])
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package commander
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRecentEventsKeepsLastNAndEvictsOlder(t *testing.T) {
+	t.Parallel()
+
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		CommanderConfig{WIPLimit: 1, EventLogSize: 3},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if err := cmd.publish(context.Background(), Event{Type: EventMissionCompleted, MissionID: fmt.Sprintf("m%d", i)}); err != nil {
+			t.Fatalf("publish m%d: %v", i, err)
+		}
+	}
+
+	assertEventMissionIDs(t, cmd.RecentEvents(0), []string{"m3", "m4", "m5"})
+	assertEventMissionIDs(t, cmd.RecentEvents(2), []string{"m4", "m5"})
+	assertEventMissionIDs(t, cmd.RecentEvents(10), []string{"m3", "m4", "m5"})
+}
+
+func TestPublishAssignsMonotonicSequenceAcrossConcurrentPublishes(t *testing.T) {
+	t.Parallel()
+
+	const publishers, perPublisher = 8, 25
+	total := publishers * perPublisher
+	events := &fakeEventPublisher{}
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		events,
+		CommanderConfig{WIPLimit: 1, EventLogSize: total},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for p := 0; p < publishers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perPublisher; i++ {
+				event := Event{Type: EventMissionCompleted, MissionID: fmt.Sprintf("p%d-m%d", p, i)}
+				if err := cmd.publish(context.Background(), event); err != nil {
+					t.Errorf("publish: %v", err)
+				}
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	recent := cmd.RecentEvents(0)
+	if len(recent) != total {
+		t.Fatalf("recent events = %d, want %d", len(recent), total)
+	}
+	for i, event := range recent {
+		if event.Seq != uint64(i+1) {
+			t.Fatalf("recent[%d].Seq = %d, want %d (strictly increasing, no gaps)", i, event.Seq, i+1)
+		}
+	}
+
+	seen := make(map[uint64]bool, total)
+	for _, event := range events.events {
+		if event.Seq == 0 || seen[event.Seq] {
+			t.Fatalf("published seq %d is zero or duplicated", event.Seq)
+		}
+		seen[event.Seq] = true
+	}
+}
+
+func TestRecentEventsEmptyBeforePublish(t *testing.T) {
+	t.Parallel()
+
+	ring := newEventRing(4)
+	if got := ring.recent(2); len(got) != 0 {
+		t.Fatalf("recent events = %v, want none", got)
+	}
+}
+
+func assertEventMissionIDs(t *testing.T, events []Event, want []string) {
+	t.Helper()
+
+	if len(events) != len(want) {
+		t.Fatalf("events = %v, want mission ids %v", events, want)
+	}
+	for i, event := range events {
+		if event.MissionID != want[i] {
+			t.Fatalf("event[%d] mission = %q, want %q", i, event.MissionID, want[i])
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package commander
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExecutionRecord is the durable analytics record written when a mission reaches a terminal event.
+type ExecutionRecord struct {
+	CommissionID  string     `json:"commission_id"`
+	MissionID     string     `json:"mission_id"`
+	WaveIndex     int        `json:"wave_index"`
+	Outcome       string     `json:"outcome"`
+	HaltReason    HaltReason `json:"halt_reason,omitempty"`
+	Message       string     `json:"message,omitempty"`
+	RevisionCount int        `json:"revision_count"`
+	StartedAt     time.Time  `json:"started_at"`
+	FinishedAt    time.Time  `json:"finished_at"`
+	DurationMS    int64      `json:"duration_ms"`
+}
+
+// ExecutionHistoryStore persists one record per mission terminal event (completion or halt).
+type ExecutionHistoryStore interface {
+	RecordExecution(ctx context.Context, record ExecutionRecord) error
+}
+
+// ExecutionHistoryQuery filters execution history; zero values match everything.
+type ExecutionHistoryQuery struct {
+	CommissionID string
+	// Since and Until bound FinishedAt; Until is exclusive.
+	Since time.Time
+	Until time.Time
+}
+
+// Matches reports whether record satisfies the query filters.
+func (q ExecutionHistoryQuery) Matches(record ExecutionRecord) bool {
+	if commissionID := strings.TrimSpace(q.CommissionID); commissionID != "" && record.CommissionID != commissionID {
+		return false
+	}
+	if !q.Since.IsZero() && record.FinishedAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !record.FinishedAt.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// FileExecutionHistory appends execution records as JSON lines to a local file.
+type FileExecutionHistory struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileExecutionHistory creates a JSONL-backed execution history at path.
+func NewFileExecutionHistory(path string) (*FileExecutionHistory, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, errors.New("execution history path must not be empty")
+	}
+	return &FileExecutionHistory{path: path}, nil
+}
+
+// RecordExecution appends record to the history file.
+func (h *FileExecutionHistory) RecordExecution(_ context.Context, record ExecutionRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal execution record for %s: %w", record.MissionID, err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o755); err != nil {
+		return fmt.Errorf("create execution history dir: %w", err)
+	}
+	file, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open execution history: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("append execution record for %s: %w", record.MissionID, err)
+	}
+	return nil
+}
+
+// QueryExecutions returns recorded executions matching query in the order they were written.
+func (h *FileExecutionHistory) QueryExecutions(_ context.Context, query ExecutionHistoryQuery) ([]ExecutionRecord, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	file, err := os.Open(h.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open execution history: %w", err)
+	}
+	defer file.Close()
+
+	records := make([]ExecutionRecord, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var record ExecutionRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return nil, fmt.Errorf("decode execution record: %w", err)
+		}
+		if query.Matches(record) {
+			records = append(records, record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read execution history: %w", err)
+	}
+	return records, nil
+}
+
+// recordExecution writes a history record for a terminal mission event. History is analytics
+// only, so failures are logged rather than changing the mission outcome.
+func (c *Commander) recordExecution(ctx context.Context, event Event) {
+	if c.history == nil || (event.Type != EventMissionCompleted && event.Type != EventMissionHalted) {
+		return
+	}
+	finishedAt := event.Timestamp.UTC()
+	record := ExecutionRecord{
+		CommissionID: c.currentCommissionID(),
+		MissionID:    event.MissionID,
+		WaveIndex:    event.WaveIndex,
+		Outcome:      event.Type,
+		HaltReason:   event.Reason,
+		Message:      event.Message,
+		StartedAt:    finishedAt,
+		FinishedAt:   finishedAt,
+	}
+	if value, ok := c.inFlight.Load(event.MissionID); ok {
+		handle := value.(*inFlightMission)
+		record.StartedAt = handle.startedAt
+		record.RevisionCount = int(handle.revisions.Load())
+	}
+	record.DurationMS = record.FinishedAt.Sub(record.StartedAt).Milliseconds()
+
+	if err := c.history.RecordExecution(ctx, record); err != nil {
+		c.logger.Printf("commander: record execution history for mission %s: %v", event.MissionID, err)
+	}
+}
+
+func (c *Commander) currentCommissionID() string {
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+	return c.report.CommissionID
+}
+
+var _ ExecutionHistoryStore = (*FileExecutionHistory)(nil)
@@ -0,0 +1,114 @@
+package commander
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeExecutionHistory struct {
+	mu      sync.Mutex
+	records []ExecutionRecord
+}
+
+func (f *fakeExecutionHistory) RecordExecution(_ context.Context, record ExecutionRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.records = append(f.records, record)
+	return nil
+}
+
+func TestCommanderExecuteRecordsExecutionHistoryForTerminalMissions(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeManifestStore{
+		manifest: []Mission{
+			{ID: "m1", Title: "Docs", Classification: MissionClassificationStandardOps, RevisionCount: 1},
+			{ID: "m2", Title: "Blocked", Classification: MissionClassificationStandardOps, RevisionCount: 2, ManualHalt: true},
+		},
+		ready: [][]string{{"m1", "m2"}},
+	}
+	history := &fakeExecutionHistory{}
+
+	cmd, err := newCommanderForTest(
+		store,
+		&fakeWorktreeManager{paths: map[string]string{"m1": "/tmp/worktree/m1", "m2": "/tmp/worktree/m2"}},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		CommanderConfig{WIPLimit: 1, ExecutionHistory: history},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+	var clockMu sync.Mutex
+	clock := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cmd.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	_ = cmd.Execute(context.Background(), "commission-1")
+
+	if len(history.records) != 2 {
+		t.Fatalf("history records = %+v, want one per terminal mission", history.records)
+	}
+	byMission := map[string]ExecutionRecord{}
+	for _, record := range history.records {
+		byMission[record.MissionID] = record
+	}
+
+	completed := byMission["m1"]
+	if completed.Outcome != EventMissionCompleted || completed.CommissionID != "commission-1" || completed.RevisionCount != 1 {
+		t.Fatalf("completed record = %+v, want completed m1 for commission-1 with 1 revision", completed)
+	}
+	halted := byMission["m2"]
+	if halted.Outcome != EventMissionHalted || halted.HaltReason != HaltReasonManualHalt || halted.RevisionCount != 2 {
+		t.Fatalf("halted record = %+v, want manual halt with 2 revisions", halted)
+	}
+	for _, record := range []ExecutionRecord{completed, halted} {
+		if record.DurationMS <= 0 || record.DurationMS != record.FinishedAt.Sub(record.StartedAt).Milliseconds() {
+			t.Fatalf("record %s duration = %dms from %s to %s, want positive elapsed time",
+				record.MissionID, record.DurationMS, record.StartedAt, record.FinishedAt)
+		}
+	}
+}
+
+func TestFileExecutionHistoryQueriesByCommissionAndDate(t *testing.T) {
+	t.Parallel()
+
+	history, err := NewFileExecutionHistory(filepath.Join(t.TempDir(), "history", "executions.jsonl"))
+	if err != nil {
+		t.Fatalf("new history: %v", err)
+	}
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	records := []ExecutionRecord{
+		{CommissionID: "c1", MissionID: "m1", Outcome: EventMissionCompleted, FinishedAt: day.Add(2 * time.Hour)},
+		{CommissionID: "c2", MissionID: "m2", Outcome: EventMissionHalted, FinishedAt: day.Add(3 * time.Hour)},
+		{CommissionID: "c1", MissionID: "m3", Outcome: EventMissionCompleted, FinishedAt: day.Add(26 * time.Hour)},
+	}
+	for _, record := range records {
+		if err := history.RecordExecution(context.Background(), record); err != nil {
+			t.Fatalf("record %s: %v", record.MissionID, err)
+		}
+	}
+
+	got, err := history.QueryExecutions(context.Background(), ExecutionHistoryQuery{
+		CommissionID: "c1",
+		Since:        day,
+		Until:        day.Add(24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	if !reflect.DeepEqual(got, records[:1]) {
+		t.Fatalf("query = %+v, want only m1", got)
+	}
+}
@@ -16,6 +16,10 @@ import (
 const (
 	implementerRoleKey = "ensign"
 	reviewerRoleKey    = "reviewer"
+
+	// SandboxEnvVar is set to "1" for sessions working on sandboxed missions so wrappers
+	// and hooks can add isolation beyond what the harness driver enforces.
+	SandboxEnvVar = "SC3_SANDBOXED"
 )
 
 // ClaudeHarnessAdapter implements Commander Harness using a tmux-backed harness driver.
@@ -66,9 +70,13 @@ func (a *ClaudeHarnessAdapter) DispatchImplementer(ctx context.Context, req Disp
 		return DispatchResult{}, errors.New("mission id is required")
 	}
 
-	prompt, err := a.buildImplementerPrompt(req)
-	if err != nil {
-		return DispatchResult{}, err
+	prompt := strings.TrimSpace(req.Prompt)
+	if prompt == "" {
+		built, err := buildDispatchPrompt(req)
+		if err != nil {
+			return DispatchResult{}, err
+		}
+		prompt = built
 	}
 
 	model, err := a.resolveRoleModel(implementerRoleKey, req.Mission, req.Mission.Model)
@@ -80,7 +88,13 @@ func (a *ClaudeHarnessAdapter) DispatchImplementer(ctx context.Context, req Disp
 		implementerRoleKey,
 		prompt,
 		req.WorktreePath,
-		harness.SessionOpts{Model: model, MaxTurns: 1},
+		harness.SessionOpts{
+			Model:       model,
+			MaxTurns:    1,
+			SessionName: req.SessionName,
+			Env:         sandboxEnv(req.Mission),
+			Sandboxed:   req.Mission.Sandboxed,
+		},
 	)
 	if err != nil {
 		return DispatchResult{}, fmt.Errorf("spawn implementer session for %s: %w", missionID, err)
@@ -113,9 +127,11 @@ func (a *ClaudeHarnessAdapter) DispatchReviewer(ctx context.Context, req Reviewe
 		Title:              req.Mission.Title,
 		Classification:     req.Mission.Classification,
 		AcceptanceCriteria: req.AcceptanceCriteria,
+		ACRefs:             req.ACRefs,
 		GateEvidence:       req.GateEvidence,
 		CodeDiff:           req.CodeDiff,
 		DemoTokenContent:   req.DemoTokenContent,
+		RevisionDiffs:      req.RevisionDiffs,
 	})
 	if err != nil {
 		return DispatchResult{}, fmt.Errorf("build reviewer prompt for %s: %w", missionID, err)
@@ -130,7 +146,7 @@ func (a *ClaudeHarnessAdapter) DispatchReviewer(ctx context.Context, req Reviewe
 		reviewerRoleKey,
 		prompt,
 		req.WorktreePath,
-		harness.SessionOpts{Model: model, MaxTurns: 1},
+		harness.SessionOpts{Model: model, MaxTurns: 1, Env: sandboxEnv(req.Mission), Sandboxed: req.Mission.Sandboxed},
 	)
 	if err != nil {
 		return DispatchResult{}, fmt.Errorf("spawn reviewer session for %s: %w", missionID, err)
@@ -145,28 +161,27 @@ func (a *ClaudeHarnessAdapter) DispatchReviewer(ctx context.Context, req Reviewe
 		}
 	}
 
-	return DispatchResult{SessionID: strings.TrimSpace(session.ID)}, nil
+	return DispatchResult{
+		SessionID:     strings.TrimSpace(session.ID),
+		TranscriptRef: sessionTranscriptRef(session),
+	}, nil
+}
+
+// sessionTranscriptRef references the tmux pane holding a session's transcript.
+func sessionTranscriptRef(session *harness.Session) string {
+	target := strings.TrimSpace(session.TmuxSession)
+	if target == "" {
+		target = strings.TrimSpace(session.ID)
+	}
+	return "tmux:" + target
 }
 
-func (a *ClaudeHarnessAdapter) buildImplementerPrompt(req DispatchRequest) (string, error) {
-	input := ImplementerPromptContext{
-		MissionID:           req.Mission.ID,
-		Title:               req.Mission.Title,
-		Classification:      req.Mission.Classification,
-		UseCases:            req.Mission.UseCaseIDs,
-		WorktreePath:        req.WorktreePath,
-		AcceptanceCriterion: req.ReviewerFeedback,
-		MissionSpec:         req.Mission.ClassificationRationale,
-		PriorContext:        req.WaveFeedback,
-		GateFeedback:        req.ReviewerFeedback,
-	}
-	if isStandardOpsMission(req.Mission) {
-		return BuildStandardOpsPrompt(input)
-	}
-	if strings.TrimSpace(req.ReviewerFeedback) != "" {
-		return BuildGREENPrompt(input)
-	}
-	return BuildREDPrompt(input)
+// sandboxEnv returns the session environment for sandboxed missions, or nil otherwise.
+func sandboxEnv(mission Mission) map[string]string {
+	if !mission.Sandboxed {
+		return nil
+	}
+	return map[string]string{SandboxEnvVar: "1"}
 }
 
 func (a *ClaudeHarnessAdapter) resolveRoleModel(role string, mission Mission, fallbackModel string) (string, error) {
@@ -174,7 +189,13 @@ func (a *ClaudeHarnessAdapter) resolveRoleModel(role string, mission Mission, fa
 	if len(mission.UseCaseIDs) > 0 {
 		domain = mission.UseCaseIDs[0]
 	}
-	harnessName, modelName, _, err := a.cfg.ResolveHarnessModel(role, domain, a.availability)
+	// A harness pinned on the mission, or applied by the Commander from its labels, wins;
+	// otherwise the adapter's own label mapping is consulted before role/domain config.
+	harnessOverride := strings.TrimSpace(mission.Harness)
+	if harnessOverride == "" {
+		harnessOverride = config.HarnessForLabels(a.cfg.HarnessLabels, mission.Labels)
+	}
+	harnessName, modelName, _, err := a.cfg.ResolveHarnessModelWithOverride(role, domain, harnessOverride, a.availability)
 	if err != nil {
 		return "", fmt.Errorf("resolve harness/model for role %s mission %s: %w", role, mission.ID, err)
 	}
@@ -222,16 +243,23 @@ func (a *ClaudeHarnessAdapter) persistReviewVerdict(
 	reviewerSessionID,
 	output string,
 ) error {
-	verdict, feedback, ok := parseReviewVerdictOutput(output)
+	verdict, ok := parseReviewVerdictOutput(output)
 	if !ok {
 		return nil
 	}
-	payload, err := json.Marshal(map[string]string{
-		"verdict":                verdict,
-		"feedback":               feedback,
+	fields := map[string]any{
+		"verdict":                verdict.Decision,
+		"feedback":               verdict.Feedback,
 		"implementer_session_id": strings.TrimSpace(implementerSessionID),
 		"reviewer_session_id":    strings.TrimSpace(reviewerSessionID),
-	})
+	}
+	if len(verdict.ACResults) > 0 {
+		fields["ac_results"] = verdict.ACResults
+	}
+	if len(verdict.AdditionalGates) > 0 {
+		fields["additional_gates"] = verdict.AdditionalGates
+	}
+	payload, err := json.Marshal(fields)
 	if err != nil {
 		return fmt.Errorf("marshal review verdict payload for mission %s: %w", mission.ID, err)
 	}
@@ -277,7 +305,7 @@ func parseImplementerClaims(output string) []parsedClaim {
 	return claims
 }
 
-func parseReviewVerdictOutput(output string) (string, string, bool) {
+func parseReviewVerdictOutput(output string) (ReviewVerdict, bool) {
 	lines := strings.Split(output, "\n")
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -289,13 +317,19 @@ func parseReviewVerdictOutput(output string) (string, string, bool) {
 			continue
 		}
 		verdict := strings.ToUpper(strings.TrimSpace(firstNonEmptyMap(payload, "verdict", "decision")))
-		if verdict != protocol.ReviewVerdictApproved && verdict != protocol.ReviewVerdictNeedsFixes {
+		switch verdict {
+		case protocol.ReviewVerdictApproved, protocol.ReviewVerdictNeedsFixes, protocol.ReviewVerdictEscalate:
+		default:
 			continue
 		}
-		feedback := strings.TrimSpace(firstNonEmptyMap(payload, "feedback", "feedback_text", "feedbackText"))
-		return verdict, feedback, true
-	}
-	return "", "", false
+		return ReviewVerdict{
+			Decision:        verdict,
+			Feedback:        strings.TrimSpace(firstNonEmptyMap(payload, "feedback", "feedback_text", "feedbackText")),
+			ACResults:       parseACResults(json.RawMessage(line)),
+			AdditionalGates: parseAdditionalGates(json.RawMessage(line)),
+		}, true
+	}
+	return ReviewVerdict{}, false
 }
 
 func isSupportedClaimType(value string) bool {
@@ -3,11 +3,17 @@ package commander
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ship-commander/sc3/internal/config"
+	"github.com/ship-commander/sc3/internal/demo"
 	"github.com/ship-commander/sc3/internal/harness"
+	"github.com/ship-commander/sc3/internal/harness/claude"
 	"github.com/ship-commander/sc3/internal/protocol"
 )
 
@@ -73,7 +79,7 @@ func TestClaudeHarnessAdapterDispatchReviewerParsesVerdict(t *testing.T) {
 		t.Fatalf("new adapter: %v", err)
 	}
 
-	_, err = adapter.DispatchReviewer(context.Background(), ReviewerDispatchRequest{
+	result, err := adapter.DispatchReviewer(context.Background(), ReviewerDispatchRequest{
 		Mission:              Mission{ID: "MISSION-2", Title: "Review me", Classification: MissionClassificationStandardOps},
 		WorktreePath:         "/tmp/worktree",
 		AcceptanceCriteria:   []string{"AC-1"},
@@ -85,6 +91,9 @@ func TestClaudeHarnessAdapterDispatchReviewerParsesVerdict(t *testing.T) {
 	if err != nil {
 		t.Fatalf("dispatch reviewer: %v", err)
 	}
+	if result.TranscriptRef != "tmux:rev-1" {
+		t.Fatalf("transcript ref = %q, want tmux:rev-1", result.TranscriptRef)
+	}
 
 	events, err := store.ListByMission(context.Background(), "MISSION-2")
 	if err != nil {
@@ -125,6 +134,62 @@ func TestClaudeHarnessAdapterRejectsNonClaudeResolution(t *testing.T) {
 	}
 }
 
+func TestClaudeHarnessAdapterResolvesHarnessFromMissionAndLabels(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		DefaultHarness: "codex",
+		DefaultModel:   "sonnet",
+		Roles:          map[string]config.RoleHarnessConfig{},
+		HarnessLabels:  map[string]string{"docs": "claude"},
+	}
+	tests := []struct {
+		name    string
+		mission Mission
+		wantErr bool
+	}{
+		{name: "label maps to claude", mission: Mission{ID: "MISSION-4", Labels: []string{"Docs"}}},
+		{name: "pinned harness wins over labels", mission: Mission{ID: "MISSION-5", Harness: "codex", Labels: []string{"docs"}}, wantErr: true},
+		{name: "unmapped label keeps default", mission: Mission{ID: "MISSION-6", Labels: []string{"backend"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			driver := &fakeHarnessDriver{session: &harness.Session{ID: "impl-" + tt.mission.ID}}
+			adapter, err := NewClaudeHarnessAdapter(driver, protocol.NewInMemoryStore(), cfg, nil)
+			if err != nil {
+				t.Fatalf("new adapter: %v", err)
+			}
+			_, err = adapter.DispatchImplementer(context.Background(), DispatchRequest{
+				Mission:      tt.mission,
+				WorktreePath: "/tmp/worktree",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dispatch error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// flakyTmuxRunner fails the first failures tmux new-session calls with err.
+type flakyTmuxRunner struct {
+	failures    int
+	err         error
+	newSessions int
+}
+
+func (f *flakyTmuxRunner) Run(_ context.Context, _ string, args ...string) ([]byte, error) {
+	if len(args) == 0 || args[0] != "new-session" {
+		return []byte{}, nil
+	}
+	f.newSessions++
+	if f.newSessions <= f.failures {
+		return nil, f.err
+	}
+	return []byte{}, nil
+}
+
 type fakeHarnessDriver struct {
 	session       *harness.Session
 	output        string
@@ -143,3 +208,193 @@ func (f *fakeHarnessDriver) SendMessage(_ *harness.Session, _ string) (string, e
 func (f *fakeHarnessDriver) Terminate(_ *harness.Session) error {
 	return nil
 }
+
+func TestDispatchImplementerRetriesTransientSpawnFailureThroughAdapter(t *testing.T) {
+	t.Parallel()
+
+	runner := &flakyTmuxRunner{failures: 2, err: errors.New("exit status 1 (duplicate session: sc3-ensign-mission-m1)")}
+	driver, err := claude.NewWithRunner(runner, claude.DriverConfig{})
+	if err != nil {
+		t.Fatalf("new claude driver: %v", err)
+	}
+	adapter, err := NewClaudeHarnessAdapter(driver, protocol.NewInMemoryStore(), &config.Config{
+		DefaultHarness: "claude",
+		DefaultModel:   "sonnet",
+	}, map[string]bool{"claude": true})
+	if err != nil {
+		t.Fatalf("new adapter: %v", err)
+	}
+	cmd := &Commander{
+		harness:       adapter,
+		events:        &fakeEventPublisher{},
+		logger:        &fakeLogger{},
+		now:           time.Now,
+		dispatchRetry: DispatchRetry{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+
+	result, err := cmd.dispatchImplementer(context.Background(), Mission{ID: "m1", Title: "Mission One"}, t.TempDir(), 1)
+	if err != nil {
+		t.Fatalf("dispatch implementer: %v", err)
+	}
+	if result.SessionID != "sc3-ensign-mission-m1" {
+		t.Fatalf("session id = %q, want sc3-ensign-mission-m1", result.SessionID)
+	}
+	if runner.newSessions != 3 {
+		t.Fatalf("tmux new-session calls = %d, want 3 (two transient failures retried)", runner.newSessions)
+	}
+
+	runner = &flakyTmuxRunner{failures: 1, err: errors.New("exit status 1 (no such file or directory)")}
+	driver, err = claude.NewWithRunner(runner, claude.DriverConfig{})
+	if err != nil {
+		t.Fatalf("new claude driver: %v", err)
+	}
+	if cmd.harness, err = NewClaudeHarnessAdapter(driver, protocol.NewInMemoryStore(), &config.Config{
+		DefaultHarness: "claude",
+		DefaultModel:   "sonnet",
+	}, map[string]bool{"claude": true}); err != nil {
+		t.Fatalf("new adapter: %v", err)
+	}
+	if _, err := cmd.dispatchImplementer(context.Background(), Mission{ID: "m1", Title: "Mission One"}, t.TempDir(), 1); err == nil {
+		t.Fatal("expected non-transient spawn failure to fail dispatch")
+	}
+	if runner.newSessions != 1 {
+		t.Fatalf("tmux new-session calls = %d, want 1 for a non-transient failure", runner.newSessions)
+	}
+}
+
+func TestCommanderExecuteCompletesSingleMissionAgainstEchoHarness(t *testing.T) {
+	t.Parallel()
+
+	worktree := t.TempDir()
+	protocolStore := protocol.NewInMemoryStore()
+	adapter, err := NewClaudeHarnessAdapter(harness.NewEcho(), protocolStore, &config.Config{
+		DefaultHarness: "claude",
+		DefaultModel:   "sonnet",
+	}, map[string]bool{"claude": true})
+	if err != nil {
+		t.Fatalf("new adapter: %v", err)
+	}
+
+	events := &fakeEventPublisher{}
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{
+			manifest: []Mission{{
+				ID:             "m-echo",
+				Title:          "Echo mission",
+				Classification: MissionClassificationStandardOps,
+			}},
+			ready: [][]string{{"m-echo"}},
+		},
+		&fakeWorktreeManager{paths: map[string]string{"m-echo": worktree}},
+		&fakeSurfaceLocker{},
+		adapter,
+		&fakeVerifier{},
+		echoDemoTokenValidator{validator: demo.NewValidator()},
+		events,
+		CommanderConfig{
+			WIPLimit:           1,
+			ProtocolEventStore: protocolStore,
+			ReviewPollInterval: time.Millisecond,
+			ReviewTimeout:      time.Second,
+		},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	if err := cmd.Execute(context.Background(), "commission-echo"); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	completed := false
+	for _, event := range events.events {
+		if event.Type == EventMissionCompleted && event.MissionID == "m-echo" {
+			completed = true
+		}
+	}
+	if !completed {
+		t.Fatalf("expected %s for m-echo, got %+v", EventMissionCompleted, events.events)
+	}
+	protocolEvents, err := protocolStore.ListByMission(context.Background(), "m-echo")
+	if err != nil {
+		t.Fatalf("list protocol events: %v", err)
+	}
+	verdict, implementerSessionID, reviewerSessionID, ok := parseReviewVerdict(protocolEvents[len(protocolEvents)-1], VerdictSchema{})
+	if !ok || verdict != protocol.ReviewVerdictApproved {
+		t.Fatalf("last protocol event = %+v, want APPROVED review verdict", protocolEvents[len(protocolEvents)-1])
+	}
+	if implementerSessionID != "echo-ensign-1" || reviewerSessionID != "echo-reviewer-1" {
+		t.Fatalf("verdict sessions = %q/%q, want echo-ensign-1/echo-reviewer-1", implementerSessionID, reviewerSessionID)
+	}
+}
+
+func TestCommanderHaltsWhenDemoTokenReferencesMissingFile(t *testing.T) {
+	t.Parallel()
+
+	worktree := t.TempDir()
+	token := strings.Join([]string{
+		"---",
+		`mission_id: "m-ref"`,
+		`title: "Reference check"`,
+		`classification: "STANDARD_OPS"`,
+		`status: "complete"`,
+		`created_at: "2026-02-10T14:30:00Z"`,
+		`agent_id: "ensign-1"`,
+		"---",
+		"",
+		"### manual_steps",
+		"1. Follow [the runbook](docs/runbook.md).",
+		"",
+	}, "\n")
+	if err := os.MkdirAll(filepath.Join(worktree, "demo"), 0o750); err != nil {
+		t.Fatalf("create demo dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(worktree, "demo", "MISSION-m-ref.md"), []byte(token), 0o600); err != nil {
+		t.Fatalf("write demo token: %v", err)
+	}
+
+	events := &fakeEventPublisher{}
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{
+			manifest: []Mission{{ID: "m-ref", Title: "Reference check", Classification: MissionClassificationStandardOps}},
+			ready:    [][]string{{"m-ref"}},
+		},
+		&fakeWorktreeManager{paths: map[string]string{"m-ref": worktree}},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		echoDemoTokenValidator{validator: demo.NewValidator(demo.WithReferenceCheck())},
+		events,
+		CommanderConfig{WIPLimit: 1},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	if err := cmd.Execute(context.Background(), "commission-ref"); err == nil {
+		t.Fatal("expected execute to fail on an invalid demo token")
+	}
+
+	var halt *Event
+	for i := range events.events {
+		if events.events[i].Type == EventMissionHalted {
+			halt = &events.events[i]
+		}
+	}
+	if halt == nil || halt.Reason != HaltReasonDemoTokenInvalid || !strings.Contains(halt.Message, "docs/runbook.md") {
+		t.Fatalf("halt event = %+v, want %s naming docs/runbook.md", halt, HaltReasonDemoTokenInvalid)
+	}
+}
+
+// echoDemoTokenValidator adapts the real demo token validator to the commander interface.
+type echoDemoTokenValidator struct {
+	validator *demo.Validator
+}
+
+func (v echoDemoTokenValidator) Validate(ctx context.Context, mission Mission, worktreePath string) error {
+	result := v.validator.Validate(ctx, demo.Mission{ID: mission.ID, Classification: mission.Classification}, worktreePath)
+	if !result.Valid {
+		return errors.New(result.Reason)
+	}
+	return nil
+}
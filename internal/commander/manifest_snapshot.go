@@ -0,0 +1,115 @@
+package commander
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ManifestSnapshot is the approved manifest exactly as Execute ran it, after default
+// classification, so a commission can be replayed with the same classification and waves.
+type ManifestSnapshot struct {
+	CommissionID string    `json:"commission_id"`
+	CapturedAt   time.Time `json:"captured_at"`
+	Missions     []Mission `json:"missions"`
+}
+
+// WriteManifestSnapshot writes snapshot as indented JSON to path.
+func WriteManifestSnapshot(path string, snapshot ManifestSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest snapshot: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create manifest snapshot dir: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write manifest snapshot: %w", err)
+	}
+	return nil
+}
+
+// ReadManifestSnapshot loads a snapshot written by WriteManifestSnapshot.
+func ReadManifestSnapshot(path string) (ManifestSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ManifestSnapshot{}, fmt.Errorf("read manifest snapshot: %w", err)
+	}
+	var snapshot ManifestSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return ManifestSnapshot{}, fmt.Errorf("decode manifest snapshot: %w", err)
+	}
+	if strings.TrimSpace(snapshot.CommissionID) == "" {
+		return ManifestSnapshot{}, errors.New("manifest snapshot has no commission id")
+	}
+	return snapshot, nil
+}
+
+// ManifestSnapshotPath returns the snapshot file Execute writes for commissionID under dir.
+func ManifestSnapshotPath(dir string, commissionID string) string {
+	return filepath.Join(dir, slugify(commissionID)+".manifest.json")
+}
+
+// ReplayFromSnapshot re-runs a commission from a recorded manifest snapshot using this
+// commander's harness, verifier, and gates, and returns the replay's report. Every snapshot
+// mission is reported ready, so dispatch order is driven purely by the recorded wave shape.
+//
+// The replay runs on its own execution state without the completion store, so missions the
+// original run completed are dispatched again rather than skipped, and this commander's
+// state is left untouched.
+func (c *Commander) ReplayFromSnapshot(ctx context.Context, path string) (ExecutionReport, error) {
+	snapshot, err := ReadManifestSnapshot(path)
+	if err != nil {
+		return ExecutionReport{}, err
+	}
+	replay, err := c.newReplay(snapshotManifestStore{snapshot: snapshot})
+	if err != nil {
+		return ExecutionReport{}, fmt.Errorf("build replay commander: %w", err)
+	}
+	replay.lastCommit = c.lastCommit
+	replay.sleep = c.sleep
+	replay.snapshot = c.snapshot
+	replay.diffRefs = c.diffRefs
+	replay.now = c.now
+
+	err = replay.Execute(ctx, snapshot.CommissionID)
+	return replay.Report(), err
+}
+
+// recordManifestSnapshot writes the approved manifest when a snapshot directory is configured.
+// Replays never overwrite the snapshot they were loaded from.
+func (c *Commander) recordManifestSnapshot(commissionID string, manifest []Mission) error {
+	if strings.TrimSpace(c.snapshotDir) == "" {
+		return nil
+	}
+	if _, replaying := c.manifestStore.(snapshotManifestStore); replaying {
+		return nil
+	}
+	return WriteManifestSnapshot(ManifestSnapshotPath(c.snapshotDir, commissionID), ManifestSnapshot{
+		CommissionID: commissionID,
+		CapturedAt:   c.now().UTC(),
+		Missions:     append([]Mission(nil), manifest...),
+	})
+}
+
+// snapshotManifestStore serves a recorded manifest in place of the live store during replay.
+type snapshotManifestStore struct {
+	snapshot ManifestSnapshot
+}
+
+func (s snapshotManifestStore) ReadApprovedManifest(_ context.Context, _ string) ([]Mission, error) {
+	return append([]Mission(nil), s.snapshot.Missions...), nil
+}
+
+func (s snapshotManifestStore) ReadyMissionIDs(_ context.Context, _ string) ([]string, error) {
+	ids := make([]string, 0, len(s.snapshot.Missions))
+	for _, mission := range s.snapshot.Missions {
+		ids = append(ids, mission.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,107 @@
+package commander
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReplayFromSnapshotReproducesRecordedWaves(t *testing.T) {
+	t.Parallel()
+
+	snapshotDir := t.TempDir()
+	manifest := []Mission{
+		{ID: "m1", Title: "Docs"},
+		{ID: "m2", Title: "Lint", Classification: MissionClassificationStandardOps},
+		{ID: "m3", Title: "Release notes", DependsOn: []string{"m1", "m2"}},
+	}
+	root := t.TempDir()
+	worktrees := map[string]string{}
+	for _, id := range []string{"m1", "m2", "m3"} {
+		worktrees[id] = filepath.Join(root, id)
+		if err := os.MkdirAll(filepath.Join(worktrees[id], "demo"), 0o750); err != nil {
+			t.Fatalf("create %s demo dir: %v", id, err)
+		}
+		if err := os.WriteFile(filepath.Join(worktrees[id], "demo", "MISSION-"+id+".md"), []byte("# demo evidence"), 0o600); err != nil {
+			t.Fatalf("write %s demo token: %v", id, err)
+		}
+	}
+	completions := &fakeCompletionStore{completed: map[string]bool{}}
+	cfg := CommanderConfig{
+		WIPLimit:              2,
+		DefaultClassification: MissionClassificationStandardOps,
+		ManifestSnapshotDir:   snapshotDir,
+		CompletionStore:       completions,
+		Logger:                &fakeLogger{},
+	}
+
+	harness := &fakeHarness{}
+	recorded, err := newCommanderForTest(
+		&fakeManifestStore{manifest: manifest, ready: [][]string{{"m1", "m2", "m3"}}},
+		&fakeWorktreeManager{paths: worktrees},
+		&fakeSurfaceLocker{},
+		harness,
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		cfg,
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+	if err := recorded.Execute(context.Background(), "commission-1"); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	path := ManifestSnapshotPath(snapshotDir, "commission-1")
+	snapshot, err := ReadManifestSnapshot(path)
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	if snapshot.CommissionID != "commission-1" || snapshot.CapturedAt.IsZero() {
+		t.Fatalf("snapshot header = %q at %s, want commission-1 with capture time", snapshot.CommissionID, snapshot.CapturedAt)
+	}
+	for _, mission := range snapshot.Missions {
+		if mission.Classification != MissionClassificationStandardOps {
+			t.Fatalf("snapshot mission %s classification = %q, want applied default", mission.ID, mission.Classification)
+		}
+	}
+
+	classified := append([]Mission(nil), manifest...)
+	recorded.applyDefaultClassification(classified)
+	wantWaves, err := ComputeWaves(classified)
+	if err != nil {
+		t.Fatalf("compute recorded waves: %v", err)
+	}
+	gotWaves, err := ComputeWaves(snapshot.Missions)
+	if err != nil {
+		t.Fatalf("compute snapshot waves: %v", err)
+	}
+	if !reflect.DeepEqual(gotWaves, wantWaves) {
+		t.Fatalf("snapshot waves = %+v, want %+v", gotWaves, wantWaves)
+	}
+
+	// Replay on the commander that recorded the run, with every mission persisted as
+	// completed: the replay must still dispatch each snapshot mission again.
+	completions.mu.Lock()
+	for _, mission := range manifest {
+		completions.completed[mission.ID] = true
+	}
+	completions.mu.Unlock()
+	report, err := recorded.ReplayFromSnapshot(context.Background(), path)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+
+	if len(harness.implementerDispatches) != 6 {
+		t.Fatalf("dispatches after replay = %d, want 3 recorded plus 3 replayed", len(harness.implementerDispatches))
+	}
+	if last := harness.implementerDispatches[5].Mission.ID; last != "m3" {
+		t.Fatalf("last replay dispatch = %s, want dependent mission m3", last)
+	}
+	if !reflect.DeepEqual(report.CompletedMissionIDs, []string{"m1", "m2", "m3"}) || len(report.SkippedMissionIDs) != 0 {
+		t.Fatalf("replay report = %+v, want m1 m2 m3 completed and none skipped", report)
+	}
+}
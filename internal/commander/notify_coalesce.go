@@ -0,0 +1,171 @@
+package commander
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CoalescingNotificationSink throttles NotifyTUI delivery during bursty waves. Progress events
+// for a mission are delivered at most once per window; progress arriving sooner replaces any
+// undelivered progress for that mission and is delivered when the window closes, or on Flush.
+// Other event types are delivered immediately and supersede the mission's undelivered
+// progress. Only the notification path is throttled; the commander's durable publisher still
+// records every event.
+type CoalescingNotificationSink struct {
+	target NotificationSink
+	window time.Duration
+	now    func() time.Time
+
+	mu         sync.Mutex
+	lastSent   map[string]time.Time
+	pending    map[string]Event
+	timers     map[string]*time.Timer
+	onError    func(error)
+	nextTicket uint64
+
+	// Deliveries run outside mu but in the order their tickets were taken under mu, so a
+	// held progress event flushed by its timer never lands after a later completion.
+	deliverMu   sync.Mutex
+	deliverTurn *sync.Cond
+	serving     uint64
+}
+
+// NewCoalescingNotificationSink wraps target so rapid same-mission progress events coalesce
+// to the latest one within window.
+func NewCoalescingNotificationSink(target NotificationSink, window time.Duration) (*CoalescingNotificationSink, error) {
+	if target == nil {
+		return nil, errors.New("notification sink is required")
+	}
+	if window <= 0 {
+		return nil, errors.New("coalesce window must be positive")
+	}
+	s := &CoalescingNotificationSink{
+		target:   target,
+		window:   window,
+		now:      time.Now,
+		lastSent: make(map[string]time.Time),
+		pending:  make(map[string]Event),
+		timers:   make(map[string]*time.Timer),
+	}
+	s.deliverTurn = sync.NewCond(&s.deliverMu)
+	return s, nil
+}
+
+// SetErrorHandler receives delivery errors for held progress flushed when its window closes,
+// which has no caller to return them to.
+func (s *CoalescingNotificationSink) SetErrorHandler(handler func(error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onError = handler
+}
+
+// Notify delivers event now or holds it as the mission's latest undelivered progress.
+func (s *CoalescingNotificationSink) Notify(ctx context.Context, event Event) error {
+	s.mu.Lock()
+
+	missionID := event.MissionID
+	if event.Type != EventMissionProgress || missionID == "" {
+		s.dropPendingLocked(missionID)
+		return s.deliverUnlocking(ctx, event)
+	}
+
+	now := s.now()
+	if last, sent := s.lastSent[missionID]; sent && now.Sub(last) < s.window {
+		s.pending[missionID] = event
+		if s.timers[missionID] == nil {
+			s.timers[missionID] = time.AfterFunc(s.window-now.Sub(last), func() { s.flushMission(missionID) })
+		}
+		s.mu.Unlock()
+		return nil
+	}
+	s.dropPendingLocked(missionID)
+	s.lastSent[missionID] = now
+	return s.deliverUnlocking(ctx, event)
+}
+
+// Flush delivers every held progress event, in mission ID order.
+func (s *CoalescingNotificationSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	now := s.now()
+	held := make([]Event, 0, len(s.pending))
+	for _, missionID := range slices.Sorted(maps.Keys(s.pending)) {
+		held = append(held, s.pending[missionID])
+		s.dropPendingLocked(missionID)
+		s.lastSent[missionID] = now
+	}
+	ticket := s.takeTicketUnlocking()
+	s.awaitTurn(ticket)
+	defer s.endTurn()
+
+	var errs []error
+	for _, event := range held {
+		if err := s.target.Notify(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// flushMission delivers a mission's held progress once its window closes.
+func (s *CoalescingNotificationSink) flushMission(missionID string) {
+	s.mu.Lock()
+	delete(s.timers, missionID)
+	event, held := s.pending[missionID]
+	if !held {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.pending, missionID)
+	s.lastSent[missionID] = s.now()
+	onError := s.onError
+	if err := s.deliverUnlocking(context.Background(), event); err != nil && onError != nil {
+		onError(err)
+	}
+}
+
+// dropPendingLocked discards a mission's held progress and its window timer.
+func (s *CoalescingNotificationSink) dropPendingLocked(missionID string) {
+	delete(s.pending, missionID)
+	if timer := s.timers[missionID]; timer != nil {
+		timer.Stop()
+		delete(s.timers, missionID)
+	}
+}
+
+// deliverUnlocking releases mu, which the caller holds, and delivers event to target once
+// every earlier delivery has finished.
+func (s *CoalescingNotificationSink) deliverUnlocking(ctx context.Context, event Event) error {
+	ticket := s.takeTicketUnlocking()
+	s.awaitTurn(ticket)
+	defer s.endTurn()
+	return s.target.Notify(ctx, event)
+}
+
+// takeTicketUnlocking reserves the next delivery slot and releases mu, which the caller holds.
+func (s *CoalescingNotificationSink) takeTicketUnlocking() uint64 {
+	ticket := s.nextTicket
+	s.nextTicket++
+	s.mu.Unlock()
+	return ticket
+}
+
+func (s *CoalescingNotificationSink) awaitTurn(ticket uint64) {
+	s.deliverMu.Lock()
+	defer s.deliverMu.Unlock()
+	for s.serving != ticket {
+		s.deliverTurn.Wait()
+	}
+}
+
+func (s *CoalescingNotificationSink) endTurn() {
+	s.deliverMu.Lock()
+	defer s.deliverMu.Unlock()
+	s.serving++
+	s.deliverTurn.Broadcast()
+}
+
+var _ NotificationSink = (*CoalescingNotificationSink)(nil)
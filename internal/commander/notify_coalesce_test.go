@@ -0,0 +1,164 @@
+package commander
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCoalescingNotificationSinkCoalescesProgressButPublisherRecordsAll(t *testing.T) {
+	t.Parallel()
+
+	events := &fakeEventPublisher{}
+	sink := &fakeNotificationSink{}
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		events,
+		CommanderConfig{WIPLimit: 1, NotificationSink: sink, NotificationCoalesceWindow: time.Minute},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+
+	// Harness progress is not pushed to the TUI, so publish notifying progress directly.
+	ctx := context.Background()
+	progress := func(missionID string, percent int) Event {
+		return Event{Type: EventMissionProgress, MissionID: missionID, WaveIndex: 1, Percent: percent, NotifyTUI: true}
+	}
+	for _, percent := range []int{10, 20, 30, 40, 50} {
+		if err := cmd.publish(ctx, progress("m1", percent)); err != nil {
+			t.Fatalf("publish m1 progress: %v", err)
+		}
+	}
+	if err := cmd.publish(ctx, progress("m2", 5)); err != nil {
+		t.Fatalf("publish m2 progress: %v", err)
+	}
+
+	if got := len(events.events); got != 6 {
+		t.Fatalf("durable events = %d, want all 6 progress events", got)
+	}
+	assertNotifiedProgress(t, sink.events, []string{"m1", "m2"}, []int{10, 5})
+
+	if err := cmd.flushEvents(ctx); err != nil {
+		t.Fatalf("flush events: %v", err)
+	}
+	assertNotifiedProgress(t, sink.events, []string{"m1", "m2", "m1"}, []int{10, 5, 50})
+
+	if err := cmd.publish(ctx, Event{Type: EventMissionCompleted, MissionID: "m1", NotifyTUI: true}); err != nil {
+		t.Fatalf("publish completion: %v", err)
+	}
+	if got := sink.events[len(sink.events)-1].Type; got != EventMissionCompleted {
+		t.Fatalf("last notified event = %s, want completion delivered immediately", got)
+	}
+}
+
+func TestCoalescingNotificationSinkDeliversHeldProgressWhenWindowCloses(t *testing.T) {
+	t.Parallel()
+
+	target := &fakeNotificationSink{}
+	sink, err := NewCoalescingNotificationSink(target, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new coalescing sink: %v", err)
+	}
+	ctx := context.Background()
+	for _, percent := range []int{10, 20, 30} {
+		if err := sink.Notify(ctx, Event{Type: EventMissionProgress, MissionID: "m1", Percent: percent}); err != nil {
+			t.Fatalf("notify %d%%: %v", percent, err)
+		}
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		target.mu.Lock()
+		delivered := append([]Event(nil), target.events...)
+		target.mu.Unlock()
+		if len(delivered) == 2 {
+			assertNotifiedProgress(t, delivered, []string{"m1", "m1"}, []int{10, 30})
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("delivered = %+v, want held 30%% progress delivered without a Flush", delivered)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCoalescingNotificationSinkDoesNotHoldLockWhileDelivering(t *testing.T) {
+	t.Parallel()
+
+	target := &blockingNotificationSink{release: make(chan struct{}), entered: make(chan struct{}, 1)}
+	sink, err := NewCoalescingNotificationSink(target, time.Minute)
+	if err != nil {
+		t.Fatalf("new coalescing sink: %v", err)
+	}
+	ctx := context.Background()
+	go func() {
+		_ = sink.Notify(ctx, Event{Type: EventMissionCompleted, MissionID: "m1"})
+	}()
+	<-target.entered
+	defer close(target.release)
+
+	// The first m2 progress records lastSent before waiting its turn to deliver, so the next
+	// one is held; holding must not wait on the blocked delivery.
+	go func() {
+		_ = sink.Notify(ctx, Event{Type: EventMissionProgress, MissionID: "m2", Percent: 10})
+	}()
+	deadline := time.Now().Add(time.Second)
+	for {
+		sink.mu.Lock()
+		_, sent := sink.lastSent["m2"]
+		sink.mu.Unlock()
+		if sent {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("first m2 progress never reached the sink")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	held := make(chan error, 1)
+	go func() {
+		held <- sink.Notify(ctx, Event{Type: EventMissionProgress, MissionID: "m2", Percent: 20})
+	}()
+	select {
+	case err := <-held:
+		if err != nil {
+			t.Fatalf("hold progress: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("holding progress blocked behind a slow delivery")
+	}
+}
+
+// blockingNotificationSink blocks every delivery until release is closed.
+type blockingNotificationSink struct {
+	release chan struct{}
+	entered chan struct{}
+}
+
+func (b *blockingNotificationSink) Notify(context.Context, Event) error {
+	select {
+	case b.entered <- struct{}{}:
+	default:
+	}
+	<-b.release
+	return nil
+}
+
+func assertNotifiedProgress(t *testing.T, notified []Event, missionIDs []string, percents []int) {
+	t.Helper()
+	if len(notified) != len(missionIDs) {
+		t.Fatalf("notified events = %+v, want %d", notified, len(missionIDs))
+	}
+	for i, event := range notified {
+		if event.MissionID != missionIDs[i] || event.Percent != percents[i] {
+			t.Fatalf("notified event %d = %s %d%%, want %s %d%%", i, event.MissionID, event.Percent, missionIDs[i], percents[i])
+		}
+	}
+}
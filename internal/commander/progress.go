@@ -0,0 +1,109 @@
+package commander
+
+import (
+	"strings"
+	"sync"
+)
+
+// progressTracker records a per-mission completion fraction (0-1) and the missions in each
+// wave so wave progress can be aggregated while missions run concurrently. A nil tracker
+// ignores updates and reports zero progress.
+type progressTracker struct {
+	mu        sync.RWMutex
+	waves     map[int][]string
+	fractions map[string]float64
+}
+
+func newProgressTracker() *progressTracker {
+	return &progressTracker{
+		waves:     make(map[int][]string),
+		fractions: make(map[string]float64),
+	}
+}
+
+// registerWave records the missions whose progress makes up a wave.
+func (p *progressTracker) registerWave(waveIndex int, missionIDs []string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.waves[waveIndex] = append([]string(nil), missionIDs...)
+}
+
+// set records a mission's completion fraction, clamped to 0-1.
+func (p *progressTracker) set(missionID string, fraction float64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fractions[missionID] = min(max(fraction, 0), 1)
+}
+
+// wave averages the completion fraction of a wave's missions; missions without progress
+// count as zero and unknown waves report zero.
+func (p *progressTracker) wave(waveIndex int) float64 {
+	if p == nil {
+		return 0
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	missionIDs := p.waves[waveIndex]
+	if len(missionIDs) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, missionID := range missionIDs {
+		total += p.fractions[missionID]
+	}
+	return total / float64(len(missionIDs))
+}
+
+// track derives mission progress from a published commander event.
+func (p *progressTracker) track(event Event) {
+	if p == nil {
+		return
+	}
+	switch event.Type {
+	case EventMissionProgress:
+		p.set(event.MissionID, float64(event.Percent)/100)
+	case EventMissionCompleted, EventMissionSkipped:
+		p.set(event.MissionID, 1)
+	}
+}
+
+// acCompletion returns the fraction of acceptance criteria a reviewer marked as passed. When
+// the mission lists its AC refs, progress is measured against them: results for other IDs are
+// ignored and refs the reviewer did not report count as not yet passed.
+func acCompletion(results []ACResult, refs []string) float64 {
+	if len(refs) == 0 {
+		if len(results) == 0 {
+			return 0
+		}
+		passed := 0
+		for _, result := range results {
+			if result.Passed {
+				passed++
+			}
+		}
+		return float64(passed) / float64(len(results))
+	}
+
+	pending := make(map[string]struct{}, len(refs))
+	for _, ref := range refs {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			pending[ref] = struct{}{}
+		}
+	}
+	if len(pending) == 0 {
+		return 0
+	}
+	total := len(pending)
+	for _, result := range results {
+		if _, ok := pending[result.ACID]; ok && result.Passed {
+			delete(pending, result.ACID)
+		}
+	}
+	return float64(total-len(pending)) / float64(total)
+}
@@ -0,0 +1,97 @@
+package commander
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ship-commander/sc3/internal/protocol"
+)
+
+func TestWaveProgressAggregatesPartialACCompletion(t *testing.T) {
+	t.Parallel()
+
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		CommanderConfig{WIPLimit: 2},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+	cmd.progress.registerWave(1, []string{"m1", "m2"})
+
+	mission := Mission{ID: "m1", MaxRevisions: 3}
+	if _, err := cmd.handleReviewVerdict(context.Background(), "m1", 1, &mission, 3, ReviewVerdict{
+		Decision: protocol.ReviewVerdictNeedsFixes,
+		Feedback: "AC-4 still fails",
+		ACResults: []ACResult{
+			{ACID: "AC-1", Passed: true},
+			{ACID: "AC-2", Passed: true},
+			{ACID: "AC-3", Passed: true},
+			{ACID: "AC-4", Passed: false},
+		},
+	}); err != nil {
+		t.Fatalf("handle review verdict: %v", err)
+	}
+	assertWaveProgress(t, cmd.WaveProgress(1), 0.375)
+
+	reporter := &missionProgressReporter{commander: cmd, missionID: "m2", waveIndex: 1}
+	if err := reporter.ReportProgress(context.Background(), MissionProgress{Phase: "GREEN", Percent: 50}); err != nil {
+		t.Fatalf("report progress: %v", err)
+	}
+	assertWaveProgress(t, cmd.WaveProgress(1), 0.625)
+
+	if err := cmd.publish(context.Background(), Event{Type: EventMissionCompleted, MissionID: "m1", WaveIndex: 1}); err != nil {
+		t.Fatalf("publish completion: %v", err)
+	}
+	assertWaveProgress(t, cmd.WaveProgress(1), 0.75)
+	assertWaveProgress(t, cmd.WaveProgress(2), 0)
+}
+
+func TestWaveProgressMeasuresACCompletionAgainstMissionACRefs(t *testing.T) {
+	t.Parallel()
+
+	cmd, err := newCommanderForTest(
+		&fakeManifestStore{},
+		&fakeWorktreeManager{},
+		&fakeSurfaceLocker{},
+		&fakeHarness{},
+		&fakeVerifier{},
+		&fakeDemoTokenValidator{},
+		&fakeEventPublisher{},
+		CommanderConfig{WIPLimit: 1},
+	)
+	if err != nil {
+		t.Fatalf("new commander: %v", err)
+	}
+	cmd.progress.registerWave(1, []string{"m1"})
+
+	// AC-9 is not one of the mission's refs and AC-3 was never reported.
+	mission := Mission{ID: "m1", MaxRevisions: 3, ACRefs: []string{"AC-1", "AC-2", "AC-3", "AC-4"}}
+	if _, err := cmd.handleReviewVerdict(context.Background(), "m1", 1, &mission, 3, ReviewVerdict{
+		Decision: protocol.ReviewVerdictNeedsFixes,
+		Feedback: "AC-3 and AC-4 still fail",
+		ACResults: []ACResult{
+			{ACID: "AC-1", Passed: true},
+			{ACID: "AC-2", Passed: true},
+			{ACID: "AC-4", Passed: false},
+			{ACID: "AC-9", Passed: true},
+		},
+	}); err != nil {
+		t.Fatalf("handle review verdict: %v", err)
+	}
+	assertWaveProgress(t, cmd.WaveProgress(1), 0.5)
+}
+
+func assertWaveProgress(t *testing.T, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("wave progress = %v, want %v", got, want)
+	}
+}
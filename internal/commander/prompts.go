@@ -40,9 +40,11 @@ type ReviewerPromptContext struct {
 	Title              string
 	Classification     string
 	AcceptanceCriteria []string
+	ACRefs             []string
 	GateEvidence       []string
 	CodeDiff           string
 	DemoTokenContent   string
+	RevisionDiffs      []RevisionDiff
 }
 
 // BuildClassificationPrompt renders the commander mission-risk prompt with mission context.
@@ -136,17 +138,21 @@ func BuildReviewerPrompt(input ReviewerPromptContext) (string, error) {
 		Title                  string
 		Classification         string
 		AcceptanceCriteriaText string
+		ACRefsText             string
 		GateEvidenceText       string
 		CodeDiff               string
 		DemoTokenContent       string
+		RevisionHistory        string
 	}{
 		MissionID:              strings.TrimSpace(input.MissionID),
 		Title:                  strings.TrimSpace(input.Title),
 		Classification:         strings.TrimSpace(input.Classification),
 		AcceptanceCriteriaText: joinLines(input.AcceptanceCriteria),
+		ACRefsText:             joinLines(input.ACRefs),
 		GateEvidenceText:       joinLines(input.GateEvidence),
 		CodeDiff:               strings.TrimSpace(input.CodeDiff),
 		DemoTokenContent:       strings.TrimSpace(input.DemoTokenContent),
+		RevisionHistory:        formatRevisionDiffs(input.RevisionDiffs),
 	}
 	if renderInput.MissionID == "" {
 		return "", fmt.Errorf("mission id is required for reviewer prompt")
@@ -172,6 +178,30 @@ func BuildReviewerPrompt(input ReviewerPromptContext) (string, error) {
 	return renderTemplate("reviewer.tmpl", renderInput)
 }
 
+// buildDispatchPrompt selects the implementer template for a dispatch: STANDARD_OPS
+// missions use the standard template, reviewer feedback moves RED_ALERT work to GREEN.
+func buildDispatchPrompt(req DispatchRequest) (string, error) {
+	input := ImplementerPromptContext{
+		MissionID:           req.Mission.ID,
+		Title:               req.Mission.Title,
+		Classification:      req.Mission.Classification,
+		UseCases:            req.Mission.UseCaseIDs,
+		WorktreePath:        req.WorktreePath,
+		AcceptanceCriterion: req.ReviewerFeedback,
+		MissionSpec:         req.Mission.ClassificationRationale,
+		PriorContext:        req.WaveFeedback,
+		GateFeedback:        req.ReviewerFeedback,
+		ValidationCommands:  req.AdditionalGates,
+	}
+	if isStandardOpsMission(req.Mission) {
+		return BuildStandardOpsPrompt(input)
+	}
+	if strings.TrimSpace(req.ReviewerFeedback) != "" {
+		return BuildGREENPrompt(input)
+	}
+	return BuildREDPrompt(input)
+}
+
 func buildImplementerPrompt(templateName string, input ImplementerPromptContext) (string, error) {
 	renderInput := struct {
 		MissionID              string
@@ -266,6 +296,19 @@ func joinDependencies(dependencies []string) string {
 	return strings.Join(normalized, ", ")
 }
 
+// formatRevisionDiffs renders each revision step under its own header, oldest first.
+func formatRevisionDiffs(diffs []RevisionDiff) string {
+	sections := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		body := strings.TrimSpace(diff.Diff)
+		if body == "" {
+			body = "(no changes)"
+		}
+		sections = append(sections, fmt.Sprintf("Revision %d -> %d\n%s", diff.FromRevision, diff.ToRevision, body))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
 func joinLines(values []string) string {
 	normalized := make([]string, 0, len(values))
 	for _, value := range values {
@@ -91,6 +91,59 @@ func TestBuildReviewerPromptIncludesVerdictContract(t *testing.T) {
 	}
 }
 
+func TestBuildReviewerPromptIncludesRevisionHistory(t *testing.T) {
+	t.Parallel()
+
+	prompt, err := BuildReviewerPrompt(ReviewerPromptContext{
+		MissionID: "MISSION-203",
+		RevisionDiffs: []RevisionDiff{
+			{FromRevision: 0, ToRevision: 1, Diff: "+guard empty input"},
+			{FromRevision: 1, ToRevision: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("build reviewer prompt: %v", err)
+	}
+	for _, needle := range []string{"Revision History", "Revision 0 -> 1\n+guard empty input", "Revision 1 -> 2\n(no changes)"} {
+		if !strings.Contains(prompt, needle) {
+			t.Fatalf("prompt missing %q:\n%s", needle, prompt)
+		}
+	}
+
+	prompt, err = BuildReviewerPrompt(ReviewerPromptContext{MissionID: "MISSION-203"})
+	if err != nil {
+		t.Fatalf("build reviewer prompt: %v", err)
+	}
+	if strings.Contains(prompt, "Revision History") {
+		t.Fatalf("prompt without revision diffs rendered a history section:\n%s", prompt)
+	}
+}
+
+func TestBuildReviewerPromptAsksForResultsPerACRef(t *testing.T) {
+	t.Parallel()
+
+	prompt, err := BuildReviewerPrompt(ReviewerPromptContext{
+		MissionID: "MISSION-204",
+		ACRefs:    []string{"AC-1", "AC-2"},
+	})
+	if err != nil {
+		t.Fatalf("build reviewer prompt: %v", err)
+	}
+	for _, needle := range []string{"Acceptance Criterion IDs\n- AC-1\n- AC-2", "ac_results:", "one entry per Acceptance Criterion ID"} {
+		if !strings.Contains(prompt, needle) {
+			t.Fatalf("prompt missing %q:\n%s", needle, prompt)
+		}
+	}
+
+	prompt, err = BuildReviewerPrompt(ReviewerPromptContext{MissionID: "MISSION-204"})
+	if err != nil {
+		t.Fatalf("build reviewer prompt: %v", err)
+	}
+	if strings.Contains(prompt, "ac_results") {
+		t.Fatalf("prompt without AC refs asked for ac_results:\n%s", prompt)
+	}
+}
+
 func TestBuildPromptRejectsMissingMissionID(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,8 @@ type GateVerifierConfig struct {
 	OutputSnippetBytes int
 	ProjectCommands    map[string][]string
 	GreenInfraCommands []string
+	// CacheResults skips re-running a gate within a mission when its command and worktree are unchanged.
+	CacheResults bool
 }
 
 // GateVerifierAdapter implements Verifier using the deterministic gates runner.
@@ -48,6 +50,7 @@ func NewGateVerifierAdapter(
 		OutputSnippetBytes: config.OutputSnippetBytes,
 		ProjectCommands:    config.ProjectCommands,
 		GreenInfraCommands: config.GreenInfraCommands,
+		CacheResults:       config.CacheResults,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create gates runner: %w", err)
@@ -90,6 +93,16 @@ func (v *GateVerifierAdapter) VerifyImplement(ctx context.Context, mission Missi
 	return v.runGate(ctx, missionID, worktreePath, gates.GateTypeVerifyIMPLEMENT)
 }
 
+// InvalidateCache drops the cached gate results for a finished mission.
+func (v *GateVerifierAdapter) InvalidateCache(missionID string) {
+	if v == nil {
+		return
+	}
+	if invalidator, ok := v.runner.(gateCacheInvalidator); ok {
+		invalidator.InvalidateCache(missionID)
+	}
+}
+
 func (v *GateVerifierAdapter) runGate(ctx context.Context, missionID, worktreePath, gateType string) error {
 	if v == nil || v.runner == nil {
 		return errors.New("gate verifier runner is required")
@@ -108,6 +121,12 @@ func (v *GateVerifierAdapter) runGate(ctx context.Context, missionID, worktreePa
 	return nil
 }
 
+// gateCacheInvalidator is implemented by verifiers and gate runners that cache gate
+// results per mission, such as gates.Runner with CacheResults.
+type gateCacheInvalidator interface {
+	InvalidateCache(missionID string)
+}
+
 type protocolGateEvidenceStore struct {
 	store protocol.EventStore
 	now   func() time.Time
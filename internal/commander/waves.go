@@ -8,13 +8,16 @@ import (
 )
 
 // ComputeWaves topologically sorts missions into dependency-safe wave batches.
+//
+// WaveHint orders missions relative to each other rather than pinning an absolute wave:
+// levels no mission occupies are dropped, so a lone mission hinted to wave 5 after a single
+// wave-1 mission runs in wave 2. The returned slice therefore never holds an empty wave.
 func ComputeWaves(missions []Mission) ([][]Mission, error) {
 	if len(missions) == 0 {
 		return [][]Mission{}, nil
 	}
 
 	byID := make(map[string]Mission, len(missions))
-	index := make(map[string]int, len(missions))
 	for i, mission := range missions {
 		if strings.TrimSpace(mission.ID) == "" {
 			return nil, fmt.Errorf("mission at index %d has empty id", i)
@@ -23,7 +26,6 @@ func ComputeWaves(missions []Mission) ([][]Mission, error) {
 			return nil, fmt.Errorf("duplicate mission id %q", mission.ID)
 		}
 		byID[mission.ID] = mission
-		index[mission.ID] = i
 	}
 
 	indegree := make(map[string]int, len(missions))
@@ -42,41 +44,53 @@ func ComputeWaves(missions []Mission) ([][]Mission, error) {
 		}
 	}
 
-	current := make([]string, 0, len(missions))
+	// Kahn's traversal assigns each mission the first wave after all its dependencies, raised
+	// to its WaveHint when the planner pre-grouped it later.
+	level := make(map[string]int, len(missions))
+	queue := make([]string, 0, len(missions))
 	for _, mission := range missions {
 		if indegree[mission.ID] == 0 {
-			current = append(current, mission.ID)
+			queue = append(queue, mission.ID)
 		}
 	}
+	for _, mission := range missions {
+		level[mission.ID] = max(1, mission.WaveHint)
+	}
 
 	visited := 0
-	waves := make([][]Mission, 0)
-	for len(current) > 0 {
-		sort.SliceStable(current, func(i, j int) bool {
-			return index[current[i]] < index[current[j]]
-		})
-
-		wave := make([]Mission, 0, len(current))
-		next := make([]string, 0)
-		for _, id := range current {
-			wave = append(wave, byID[id])
-			visited++
-			for _, child := range children[id] {
-				indegree[child]--
-				if indegree[child] == 0 {
-					next = append(next, child)
-				}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, child := range children[id] {
+			level[child] = max(level[child], level[id]+1)
+			indegree[child]--
+			if indegree[child] == 0 {
+				queue = append(queue, child)
 			}
 		}
-
-		waves = append(waves, wave)
-		current = next
 	}
 
 	if visited != len(missions) {
 		return nil, fmt.Errorf("dependency cycle detected among missions")
 	}
 
+	// Levels with no missions are dropped, compacting hinted waves as documented above.
+	byLevel := make(map[int][]Mission)
+	levels := make([]int, 0)
+	for _, mission := range missions {
+		l := level[mission.ID]
+		if _, ok := byLevel[l]; !ok {
+			levels = append(levels, l)
+		}
+		byLevel[l] = append(byLevel[l], mission)
+	}
+	sort.Ints(levels)
+
+	waves := make([][]Mission, 0, len(levels))
+	for _, l := range levels {
+		waves = append(waves, byLevel[l])
+	}
 	return waves, nil
 }
 
@@ -10,7 +10,8 @@ import (
 	tooltrace "github.com/ship-commander/sc3/internal/tracing"
 )
 
-type shellRunner interface {
+// CommandRunner runs an external command in dir and returns its stdout and stderr.
+type CommandRunner interface {
 	Run(ctx context.Context, dir string, name string, args ...string) ([]byte, []byte, error)
 }
 
@@ -21,14 +22,30 @@ func (commandRunner) Run(ctx context.Context, dir string, name string, args ...s
 	return []byte(stdout), []byte(stderr), err
 }
 
+// sandboxRootPerm keeps sandboxed worktrees private to the user running sc3.
+const sandboxRootPerm = 0o700
+
+// WorktreeConfig tunes how GitWorktreeManager provisions mission worktrees.
+type WorktreeConfig struct {
+	// SandboxRoot is the restricted root for sandboxed mission worktrees
+	// (default .beads/sandbox/worktrees). It is created, or tightened, to owner-only access.
+	SandboxRoot string
+}
+
 // GitWorktreeManager creates per-mission git worktrees with deterministic naming.
 type GitWorktreeManager struct {
 	projectRoot string
-	runner      shellRunner
+	runner      CommandRunner
+	cfg         WorktreeConfig
 }
 
 // NewGitWorktreeManager returns a worktree manager rooted at projectRoot.
 func NewGitWorktreeManager(projectRoot string) (*GitWorktreeManager, error) {
+	return NewGitWorktreeManagerWithConfig(projectRoot, WorktreeConfig{})
+}
+
+// NewGitWorktreeManagerWithConfig returns a worktree manager rooted at projectRoot using cfg.
+func NewGitWorktreeManagerWithConfig(projectRoot string, cfg WorktreeConfig) (*GitWorktreeManager, error) {
 	root := strings.TrimSpace(projectRoot)
 	if root == "" {
 		cwd, err := os.Getwd()
@@ -41,17 +58,19 @@ func NewGitWorktreeManager(projectRoot string) (*GitWorktreeManager, error) {
 	return &GitWorktreeManager{
 		projectRoot: root,
 		runner:      commandRunner{},
+		cfg:         cfg,
 	}, nil
 }
 
-func newGitWorktreeManagerForTest(projectRoot string, runner shellRunner) *GitWorktreeManager {
+func newGitWorktreeManagerForTest(projectRoot string, runner CommandRunner) *GitWorktreeManager {
 	return &GitWorktreeManager{
 		projectRoot: projectRoot,
 		runner:      runner,
 	}
 }
 
-// Create creates a mission worktree in .beads/worktrees with feature branch naming.
+// Create creates a mission worktree in .beads/worktrees (or the sandbox root for sandboxed
+// missions) with feature branch naming.
 func (m *GitWorktreeManager) Create(ctx context.Context, mission Mission) (string, error) {
 	if m == nil {
 		return "", fmt.Errorf("worktree manager is nil")
@@ -63,14 +82,55 @@ func (m *GitWorktreeManager) Create(ctx context.Context, mission Mission) (strin
 		return "", fmt.Errorf("worktree runner is nil")
 	}
 
-	token := missionToken(mission.ID)
-	worktreePath := filepath.Join(m.projectRoot, ".beads", "worktrees", token)
-	branch := fmt.Sprintf("feature/%s-%s", token, mission.Slug())
+	if mission.Sandboxed {
+		if err := restrictSandboxRoot(m.worktreeRoot(mission)); err != nil {
+			return "", err
+		}
+	}
+	worktreePath := m.Path(mission)
+	branch := fmt.Sprintf("feature/%s-%s", missionToken(mission.ID), mission.Slug())
 
 	args := []string{"worktree", "add", worktreePath, "-b", branch}
-	if _, stderr, err := m.runner.Run(ctx, m.projectRoot, "git", args...); err != nil {
-		return "", fmt.Errorf("git %s: %w (stderr: %s)", strings.Join(args, " "), err, strings.TrimSpace(string(stderr)))
+	if _, err := m.git(ctx, m.projectRoot, args...); err != nil {
+		return "", err
 	}
 
 	return worktreePath, nil
 }
+
+// Path returns the worktree path Create uses for mission, without creating it.
+func (m *GitWorktreeManager) Path(mission Mission) string {
+	return filepath.Join(m.worktreeRoot(mission), missionToken(mission.ID))
+}
+
+// worktreeRoot returns the parent directory for a mission's worktree; sandboxed missions
+// are placed under the restricted sandbox root.
+func (m *GitWorktreeManager) worktreeRoot(mission Mission) string {
+	if !mission.Sandboxed {
+		return filepath.Join(m.projectRoot, ".beads", "worktrees")
+	}
+	if root := strings.TrimSpace(m.cfg.SandboxRoot); root != "" {
+		return filepath.Clean(root)
+	}
+	return filepath.Join(m.projectRoot, ".beads", "sandbox", "worktrees")
+}
+
+// restrictSandboxRoot creates root with owner-only permissions, tightening an existing
+// directory so other local users cannot read or write sandboxed worktrees.
+func restrictSandboxRoot(root string) error {
+	if err := os.MkdirAll(root, sandboxRootPerm); err != nil {
+		return fmt.Errorf("create sandbox root %s: %w", root, err)
+	}
+	if err := os.Chmod(root, sandboxRootPerm); err != nil {
+		return fmt.Errorf("restrict sandbox root %s: %w", root, err)
+	}
+	return nil
+}
+
+func (m *GitWorktreeManager) git(ctx context.Context, dir string, args ...string) (string, error) {
+	stdout, stderr, err := m.runner.Run(ctx, dir, "git", args...)
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w (stderr: %s)", strings.Join(args, " "), err, strings.TrimSpace(string(stderr)))
+	}
+	return strings.TrimSpace(string(stdout)), nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,8 +15,25 @@ import (
 	"github.com/yuin/goldmark/extension"
 	extast "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/text"
+	"gopkg.in/yaml.v3"
 )
 
+// waveAnnotation matches a "Wave: N" hint inside a use case description.
+var waveAnnotation = regexp.MustCompile(`(?i)\bwave\s*:\s*(\d+)`)
+
+// acPrefix matches an explicit acceptance criterion number such as "AC-3:" at the start of
+// an entry; bold markers are already stripped by plainText.
+var acPrefix = regexp.MustCompile(`(?i)^AC-(\d+)\s*[:.)\x{2013}\x{2014}-]?\s*`)
+
+// prdFrontMatter is the optional YAML front-matter block at the top of a PRD.
+type prdFrontMatter struct {
+	Harness  string `yaml:"harness"`
+	Model    string `yaml:"model"`
+	ShipName string `yaml:"ship_name"`
+	// Metadata holds every top-level key, including ones without a typed field.
+	Metadata map[string]string `yaml:"-"`
+}
+
 // ParseFile reads and parses a PRD markdown file into a Commission.
 func ParseFile(ctx context.Context, path string) (*Commission, error) {
 	content, err := os.ReadFile(path)
@@ -36,12 +55,18 @@ func ParseMarkdown(ctx context.Context, title, markdown string) (*Commission, er
 		goldmark.WithExtensions(extension.GFM),
 	)
 
-	source := []byte(markdown)
+	frontMatter, body, err := splitFrontMatter(markdown)
+	if err != nil {
+		return nil, err
+	}
+
+	source := []byte(body)
 	doc := parser.Parser().Parse(text.NewReader(source))
 	useCases := extractUseCases(source, doc)
 	criteria := extractAcceptanceCriteria(source, doc)
 	functionalGroups := extractFunctionalGroups(source, doc)
 	scope := extractScopeBoundaries(source, doc)
+	glossary := extractGlossary(source, doc)
 
 	_ = ctx
 	return &Commission{
@@ -53,9 +78,78 @@ func ParseMarkdown(ctx context.Context, title, markdown string) (*Commission, er
 		ScopeBoundaries:    scope,
 		PRDContent:         markdown,
 		CreatedAt:          time.Now().UTC(),
+		DefaultHarness:     strings.TrimSpace(frontMatter.Harness),
+		DefaultModel:       strings.TrimSpace(frontMatter.Model),
+		ShipName:           strings.TrimSpace(frontMatter.ShipName),
+		Metadata:           frontMatter.Metadata,
+		Glossary:           glossary,
 	}, nil
 }
 
+// splitFrontMatter separates a leading "---" delimited YAML block from the PRD body.
+func splitFrontMatter(markdown string) (prdFrontMatter, string, error) {
+	normalized := strings.ReplaceAll(markdown, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return prdFrontMatter{}, markdown, nil
+	}
+	rest := strings.TrimPrefix(normalized, "---\n")
+	end := strings.Index(rest, "\n---")
+	if end == -1 {
+		return prdFrontMatter{}, markdown, nil
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(rest[:end]), &document); err != nil {
+		return prdFrontMatter{}, "", fmt.Errorf("parse PRD front-matter: %w", err)
+	}
+	var frontMatter prdFrontMatter
+	if len(document.Content) > 0 {
+		if err := document.Content[0].Decode(&frontMatter); err != nil {
+			return prdFrontMatter{}, "", fmt.Errorf("parse PRD front-matter: %w", err)
+		}
+		metadata, err := frontMatterMetadata(document.Content[0])
+		if err != nil {
+			return prdFrontMatter{}, "", fmt.Errorf("parse PRD front-matter: %w", err)
+		}
+		frontMatter.Metadata = metadata
+	}
+	body := rest[end+len("\n---"):]
+	if newline := strings.Index(body, "\n"); newline != -1 {
+		body = body[newline+1:]
+	} else {
+		body = ""
+	}
+	return frontMatter, body, nil
+}
+
+// frontMatterMetadata flattens the front-matter's top-level keys into strings. Scalars keep
+// their literal value; lists and maps are kept as compact YAML.
+func frontMatterMetadata(mapping *yaml.Node) (map[string]string, error) {
+	if mapping.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("front-matter must be a mapping of keys to values")
+	}
+
+	metadata := make(map[string]string, len(mapping.Content)/2)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := strings.TrimSpace(mapping.Content[i].Value)
+		value := mapping.Content[i+1]
+		if key == "" {
+			continue
+		}
+		if value.Kind == yaml.ScalarNode {
+			metadata[key] = strings.TrimSpace(value.Value)
+			continue
+		}
+		value.Style = yaml.FlowStyle
+		encoded, err := yaml.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("encode front-matter key %q: %w", key, err)
+		}
+		metadata[key] = strings.TrimSpace(string(encoded))
+	}
+	return metadata, nil
+}
+
 func extractUseCases(source []byte, doc gast.Node) []UseCase {
 	useCases := make([]UseCase, 0)
 
@@ -82,6 +176,7 @@ func extractUseCases(source []byte, doc gast.Node) []UseCase {
 
 		titleIndex := findHeaderAny(headers, []string{"title", "use case", "use case title"})
 		descriptionIndex := findHeaderAny(headers, []string{"description", "details"})
+		waveIndex := findHeaderAny(headers, []string{"wave", "wave hint"})
 
 		for row := header.NextSibling(); row != nil; row = row.NextSibling() {
 			tableRow, ok := row.(*extast.TableRow)
@@ -100,10 +195,12 @@ func extractUseCases(source []byte, doc gast.Node) []UseCase {
 				title = ucID
 			}
 
+			description := cellAt(cells, descriptionIndex)
 			useCases = append(useCases, UseCase{
 				ID:          ucID,
 				Title:       title,
-				Description: cellAt(cells, descriptionIndex),
+				Description: description,
+				WaveHint:    parseWaveHint(cellAt(cells, waveIndex), description),
 			})
 		}
 
@@ -113,37 +210,132 @@ func extractUseCases(source []byte, doc gast.Node) []UseCase {
 	return useCases
 }
 
+// parseWaveHint reads a wave hint from a Wave cell ("2" or "Wave 2"), falling back to a
+// "Wave: N" annotation in the description. Missing or invalid hints return zero.
+func parseWaveHint(cell string, description string) int {
+	cell = strings.TrimSpace(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(cell)), "wave"))
+	if wave, err := strconv.Atoi(cell); err == nil && wave > 0 {
+		return wave
+	}
+	if match := waveAnnotation.FindStringSubmatch(description); match != nil {
+		if wave, err := strconv.Atoi(match[1]); err == nil && wave > 0 {
+			return wave
+		}
+	}
+	return 0
+}
+
+// extractAcceptanceCriteria collects checklist items anywhere in the PRD plus, under an
+// "Acceptance Criteria" heading, numbered list items and entries with an "AC-<n>" prefix
+// (usually bold). Explicit AC numbers keep their ID; the rest are numbered in document order
+// around them. Criteria with identical descriptions are kept once.
 func extractAcceptanceCriteria(source []byte, doc gast.Node) []AC {
-	criteria := make([]AC, 0)
-	acIndex := 1
+	type entry struct {
+		number      int
+		description string
+	}
+	entries := make([]entry, 0)
+	seen := make(map[string]struct{})
+	add := func(text string) {
+		number, description := splitACPrefix(text)
+		if description == "" {
+			return
+		}
+		key := normalizeHeader(description)
+		if _, duplicate := seen[key]; duplicate {
+			return
+		}
+		seen[key] = struct{}{}
+		entries = append(entries, entry{number: number, description: description})
+	}
 
+	inSection := false
+	sectionLevel := 0
 	_ = gast.Walk(doc, func(node gast.Node, entering bool) (gast.WalkStatus, error) {
 		if !entering {
 			return gast.WalkContinue, nil
 		}
 
-		item, ok := node.(*gast.ListItem)
-		if !ok || !containsTaskCheckbox(item) {
-			return gast.WalkContinue, nil
+		switch value := node.(type) {
+		case *gast.Heading:
+			if isAcceptanceCriteriaHeading(plainText(source, value)) {
+				inSection, sectionLevel = true, value.Level
+			} else if inSection && value.Level <= sectionLevel {
+				inSection = false
+			}
+			return gast.WalkSkipChildren, nil
+		case *gast.ListItem:
+			text := strings.TrimSpace(plainText(source, value))
+			if containsTaskCheckbox(value) || (inSection && (isOrderedListItem(value) || acPrefix.MatchString(text))) {
+				add(text)
+				return gast.WalkSkipChildren, nil
+			}
+		case *gast.Paragraph:
+			if text := strings.TrimSpace(plainText(source, value)); inSection && acPrefix.MatchString(text) {
+				add(text)
+				return gast.WalkSkipChildren, nil
+			}
 		}
+		return gast.WalkContinue, nil
+	})
 
-		description := strings.TrimSpace(plainText(source, item))
-		if description == "" {
-			return gast.WalkContinue, nil
+	reserved := make(map[int]struct{}, len(entries))
+	for _, entry := range entries {
+		if entry.number > 0 {
+			reserved[entry.number] = struct{}{}
 		}
-
+	}
+	criteria := make([]AC, 0, len(entries))
+	assigned := make(map[int]struct{}, len(entries))
+	next := 1
+	for _, entry := range entries {
+		number := entry.number
+		if _, taken := assigned[number]; number <= 0 || taken {
+			for {
+				_, isReserved := reserved[next]
+				_, isAssigned := assigned[next]
+				if !isReserved && !isAssigned {
+					break
+				}
+				next++
+			}
+			number = next
+		}
+		assigned[number] = struct{}{}
 		criteria = append(criteria, AC{
-			ID:          fmt.Sprintf("AC-%03d", acIndex),
-			Description: description,
+			ID:          fmt.Sprintf("AC-%03d", number),
+			Description: entry.description,
 			Status:      "open",
 		})
-		acIndex++
-		return gast.WalkSkipChildren, nil
-	})
+	}
 
 	return criteria
 }
 
+// splitACPrefix returns the explicit AC number (zero when absent) and the description
+// without the prefix.
+func splitACPrefix(text string) (int, string) {
+	text = strings.TrimSpace(text)
+	match := acPrefix.FindStringSubmatch(text)
+	if match == nil {
+		return 0, text
+	}
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, text
+	}
+	return number, strings.TrimSpace(text[len(match[0]):])
+}
+
+func isAcceptanceCriteriaHeading(text string) bool {
+	return strings.HasPrefix(normalizeHeader(text), "acceptance criteria")
+}
+
+func isOrderedListItem(item *gast.ListItem) bool {
+	list, ok := item.Parent().(*gast.List)
+	return ok && list.IsOrdered()
+}
+
 func extractFunctionalGroups(source []byte, doc gast.Node) []string {
 	groups := make([]string, 0)
 
@@ -196,6 +388,74 @@ func extractScopeBoundaries(source []byte, doc gast.Node) ScopeConfig {
 	return scope
 }
 
+// extractGlossary reads term definitions under a "## Glossary" heading from either a
+// Term/Definition table or list items written as "Term: definition". Returns nil when the
+// PRD has no glossary entries.
+func extractGlossary(source []byte, doc gast.Node) map[string]string {
+	var glossary map[string]string
+	add := func(term, definition string) {
+		term = strings.TrimSpace(term)
+		definition = strings.TrimSpace(definition)
+		if term == "" || definition == "" {
+			return
+		}
+		if glossary == nil {
+			glossary = make(map[string]string)
+		}
+		glossary[term] = definition
+	}
+
+	var currentHeading string
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		if heading, ok := node.(*gast.Heading); ok && heading.Level == 2 {
+			currentHeading = normalizeHeader(strings.TrimSpace(plainText(source, heading)))
+			continue
+		}
+		if currentHeading != "glossary" {
+			continue
+		}
+
+		switch value := node.(type) {
+		case *gast.List:
+			for _, item := range listItemsText(source, value) {
+				term, definition, ok := splitGlossaryEntry(item)
+				if ok {
+					add(term, definition)
+				}
+			}
+		case *extast.Table:
+			header, ok := value.FirstChild().(*extast.TableHeader)
+			if !ok {
+				continue
+			}
+			headers := tableRowCells(source, header)
+			termIndex := findHeaderAny(headers, []string{"term", "name"})
+			definitionIndex := findHeaderAny(headers, []string{"definition", "meaning", "description"})
+			if termIndex == -1 || definitionIndex == -1 {
+				continue
+			}
+			for row := header.NextSibling(); row != nil; row = row.NextSibling() {
+				if tableRow, ok := row.(*extast.TableRow); ok {
+					cells := tableRowCells(source, tableRow)
+					add(cellAt(cells, termIndex), cellAt(cells, definitionIndex))
+				}
+			}
+		}
+	}
+
+	return glossary
+}
+
+// splitGlossaryEntry splits "Term: definition" (or "Term - definition") into its parts.
+func splitGlossaryEntry(entry string) (string, string, bool) {
+	for _, separator := range []string{":", " — ", " – ", " - "} {
+		if term, definition, ok := strings.Cut(entry, separator); ok {
+			return term, definition, true
+		}
+	}
+	return "", "", false
+}
+
 func tableRowCells(source []byte, row gast.Node) []string {
 	cells := make([]string, 0)
 	for child := row.FirstChild(); child != nil; child = child.NextSibling() {
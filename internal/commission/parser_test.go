@@ -4,6 +4,8 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -70,6 +72,146 @@ func TestParseMarkdownExtractsUseCasesCriteriaAndGroups(t *testing.T) {
 	}
 }
 
+func TestParseMarkdownExtractsAcceptanceCriteriaFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     []AC
+	}{
+		{
+			name: "checklist",
+			markdown: `## Acceptance Criteria
+
+- [ ] Users can sign in
+- [x] Sessions expire after an hour
+`,
+			want: []AC{
+				{ID: "AC-001", Description: "Users can sign in", Status: "open"},
+				{ID: "AC-002", Description: "Sessions expire after an hour", Status: "open"},
+			},
+		},
+		{
+			name: "numbered list",
+			markdown: `## Acceptance Criteria
+
+1. Users can sign in
+2. Sessions expire after an hour
+`,
+			want: []AC{
+				{ID: "AC-001", Description: "Users can sign in", Status: "open"},
+				{ID: "AC-002", Description: "Sessions expire after an hour", Status: "open"},
+			},
+		},
+		{
+			name: "bold AC prefixes",
+			markdown: `## Acceptance Criteria
+
+**AC-2:** Sessions expire after an hour
+
+**AC-5:** Users can sign in
+`,
+			want: []AC{
+				{ID: "AC-002", Description: "Sessions expire after an hour", Status: "open"},
+				{ID: "AC-005", Description: "Users can sign in", Status: "open"},
+			},
+		},
+		{
+			name: "numbered list outside acceptance criteria is ignored",
+			markdown: `## Rollout
+
+1. Deploy to staging
+2. Deploy to production
+`,
+			want: []AC{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commission, err := ParseMarkdown(context.Background(), "prd", tt.markdown)
+			if err != nil {
+				t.Fatalf("parse markdown: %v", err)
+			}
+			if !reflect.DeepEqual(commission.AcceptanceCriteria, tt.want) {
+				t.Fatalf("acceptance criteria = %+v, want %+v", commission.AcceptanceCriteria, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMarkdownCapturesMixedFormatAcceptanceCriteria(t *testing.T) {
+	t.Parallel()
+
+	markdown := `# Fleet PRD
+
+## Acceptance Criteria
+
+- [ ] Users can sign in
+
+1. Sessions expire after an hour
+2. Users can sign in
+
+- **AC-1:** Admins can revoke sessions
+- Not an acceptance criterion
+
+**AC-4:** Audit log records every sign-in
+
+### Security
+
+1. Passwords are hashed
+
+## Rollout
+
+1. Deploy to staging
+`
+	commission, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+
+	want := []AC{
+		{ID: "AC-002", Description: "Users can sign in", Status: "open"},
+		{ID: "AC-003", Description: "Sessions expire after an hour", Status: "open"},
+		{ID: "AC-001", Description: "Admins can revoke sessions", Status: "open"},
+		{ID: "AC-004", Description: "Audit log records every sign-in", Status: "open"},
+		{ID: "AC-005", Description: "Passwords are hashed", Status: "open"},
+	}
+	if !reflect.DeepEqual(commission.AcceptanceCriteria, want) {
+		t.Fatalf("acceptance criteria = %+v, want %+v", commission.AcceptanceCriteria, want)
+	}
+}
+
+func TestParseMarkdownReadsUseCaseWaveHints(t *testing.T) {
+	t.Parallel()
+
+	markdown := `
+## Core
+| UC ID | Title | Wave | Description |
+| --- | --- | --- | --- |
+| UC-01 | Parse PRD | 1 | Parse markdown |
+| UC-02 | Persist | Wave 2 | Save commission |
+| UC-03 | Report | | Render summary. Wave: 3 |
+| UC-04 | Audit | | No hint |
+`
+	commission, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+
+	got := map[string]int{}
+	for _, useCase := range commission.UseCases {
+		got[useCase.ID] = useCase.WaveHint
+	}
+	want := map[string]int{"UC-01": 1, "UC-02": 2, "UC-03": 3, "UC-04": 0}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("wave hints = %v, want %v", got, want)
+	}
+}
+
 func TestParseFileUsesFilenameAsFallbackTitle(t *testing.T) {
 	t.Parallel()
 
@@ -96,3 +238,132 @@ func TestParseFileUsesFilenameAsFallbackTitle(t *testing.T) {
 		t.Fatalf("use cases = %d, want 1", len(commission.UseCases))
 	}
 }
+
+func TestParseMarkdownReadsDefaultHarnessAndModelFromFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	markdown := `---
+harness: codex
+model: gpt-5-codex
+---
+## Core
+| UC ID | Title |
+| --- | --- |
+| UC-COMM-01 | Parse PRD |
+`
+	commission, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+	if commission.DefaultHarness != "codex" || commission.DefaultModel != "gpt-5-codex" {
+		t.Fatalf("defaults = (%q, %q), want (codex, gpt-5-codex)", commission.DefaultHarness, commission.DefaultModel)
+	}
+	if len(commission.UseCases) != 1 {
+		t.Fatalf("use cases = %d, want 1", len(commission.UseCases))
+	}
+}
+
+func TestParseMarkdownPreservesFrontMatterMetadata(t *testing.T) {
+	t.Parallel()
+
+	markdown := `---
+ship_name: Enterprise
+harness: claude
+priority: 2
+owning_team: platform
+tags: [billing, api]
+---
+## Core
+| UC ID | Title |
+| --- | --- |
+| UC-COMM-01 | Parse PRD |
+`
+	commission, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+	if commission.ShipName != "Enterprise" || commission.DefaultHarness != "claude" {
+		t.Fatalf("typed fields = (%q, %q), want (Enterprise, claude)", commission.ShipName, commission.DefaultHarness)
+	}
+	want := map[string]string{
+		"ship_name":   "Enterprise",
+		"harness":     "claude",
+		"priority":    "2",
+		"owning_team": "platform",
+		"tags":        "[billing, api]",
+	}
+	if !reflect.DeepEqual(commission.Metadata, want) {
+		t.Fatalf("metadata = %v, want %v", commission.Metadata, want)
+	}
+	if len(commission.UseCases) != 1 {
+		t.Fatalf("use cases = %d, want 1", len(commission.UseCases))
+	}
+}
+
+func TestParseMarkdownWithoutFrontMatterLeavesMetadataEmpty(t *testing.T) {
+	t.Parallel()
+
+	markdown := `## Core
+| UC ID | Title |
+| --- | --- |
+| UC-COMM-01 | Parse PRD |
+`
+	commission, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+	if commission.Metadata != nil || commission.ShipName != "" || commission.DefaultHarness != "" {
+		t.Fatalf("front-matter fields = (%v, %q, %q), want empty", commission.Metadata, commission.ShipName, commission.DefaultHarness)
+	}
+	if len(commission.UseCases) != 1 {
+		t.Fatalf("use cases = %d, want 1", len(commission.UseCases))
+	}
+}
+
+func TestParseMarkdownRejectsMalformedFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	markdown := `---
+ship_name: [Enterprise
+---
+## Core
+`
+	_, err := ParseMarkdown(context.Background(), "prd", markdown)
+	if err == nil || !strings.Contains(err.Error(), "parse PRD front-matter") {
+		t.Fatalf("error = %v, want wrapped front-matter parse error", err)
+	}
+}
+
+func TestParseMarkdownReadsGlossaryListAndTable(t *testing.T) {
+	t.Parallel()
+
+	markdown := `
+# Fleet PRD
+
+## Glossary
+
+- **Wave**: A set of missions that can run in parallel.
+- Demo token - Evidence file proving a mission works.
+
+| Term | Definition |
+|------|------------|
+| RED_ALERT | Mission classification requiring TDD. |
+
+## In Scope
+- Glossary parsing: not a definition
+`
+
+	commission, err := ParseMarkdown(context.Background(), "Fleet", markdown)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+
+	want := map[string]string{
+		"Wave":       "A set of missions that can run in parallel.",
+		"Demo token": "Evidence file proving a mission works.",
+		"RED_ALERT":  "Mission classification requiring TDD.",
+	}
+	if !reflect.DeepEqual(commission.Glossary, want) {
+		t.Fatalf("glossary = %#v, want %#v", commission.Glossary, want)
+	}
+}
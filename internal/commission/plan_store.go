@@ -13,6 +13,9 @@ const (
 	planStorageVersion = "v1"
 )
 
+// ErrNoPersistedPlan is returned by LoadPlan for a commission that has not been planned yet.
+var ErrNoPersistedPlan = errors.New("no persisted plan")
+
 // PlanningStatus is the persisted plan lifecycle status.
 type PlanningStatus string
 
@@ -256,7 +259,7 @@ func readPlanEnvelope(ctx context.Context, commissionID string, runner CommandRu
 	}
 	notes := strings.TrimSpace(records[0].Notes)
 	if notes == "" {
-		return persistedPlanEnvelope{}, fmt.Errorf("commission %s has no persisted plan", commissionID)
+		return persistedPlanEnvelope{}, fmt.Errorf("commission %s has %w", commissionID, ErrNoPersistedPlan)
 	}
 
 	var envelope persistedPlanEnvelope
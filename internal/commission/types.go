@@ -34,6 +34,9 @@ type UseCase struct {
 	Title              string `json:"title"`
 	Description        string `json:"description"`
 	AcceptanceCriteria []AC   `json:"acceptanceCriteria"`
+	// WaveHint is the planner's pre-grouped wave (1-based) from a Wave column or a
+	// "Wave: N" annotation; zero means no hint.
+	WaveHint int `json:"waveHint,omitempty"`
 }
 
 // ScopeConfig represents high-level scope boundaries from a PRD.
@@ -60,6 +63,18 @@ type Commission struct {
 	PRDContent         string         `json:"prdContent"`
 	Missions           []MissionTrace `json:"missions"`
 	CreatedAt          time.Time      `json:"createdAt"`
+	// DefaultHarness and DefaultModel come from PRD front-matter and apply to
+	// missions that do not specify their own harness or model.
+	DefaultHarness string `json:"defaultHarness,omitempty"`
+	DefaultModel   string `json:"defaultModel,omitempty"`
+	// ShipName comes from the PRD front-matter "ship_name" key.
+	ShipName string `json:"shipName,omitempty"`
+	// Metadata holds every top-level PRD front-matter key as a string, including keys
+	// without a typed field such as priority or owning team.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Glossary maps PRD "## Glossary" terms to their definitions so planning sessions
+	// share one vocabulary.
+	Glossary map[string]string `json:"glossary,omitempty"`
 }
 
 var allowedTransitions = map[Status]map[Status]struct{}{
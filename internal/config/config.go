@@ -39,6 +39,8 @@ type Config struct {
 	GateTimeout           time.Duration
 	LogMaxSizeBytes       int64
 	LogMaxFiles           int
+	// HarnessLabels maps mission labels to the harness used when a mission pins none.
+	HarnessLabels map[string]string
 }
 
 // RoleHarnessConfig stores role-level and domain-level harness/model overrides.
@@ -55,17 +57,18 @@ type HarnessModelConfig struct {
 }
 
 type fileConfig struct {
-	DefaultHarness        *string         `toml:"default_harness"`
-	DefaultModel          *string         `toml:"default_model"`
-	Defaults              *defaultsConfig `toml:"defaults"`
-	WIPLimit              *int            `toml:"wip_limit"`
-	MaxRevisions          *int            `toml:"max_revisions"`
-	PlanningMaxIterations *int            `toml:"planning_max_iterations"`
-	StuckTimeout          *string         `toml:"stuck_timeout"`
-	HeartbeatInterval     *string         `toml:"heartbeat_interval"`
-	GateTimeout           *string         `toml:"gate_timeout"`
-	LogMaxSizeMB          *int            `toml:"log_max_size_mb"`
-	LogMaxFiles           *int            `toml:"log_max_files"`
+	DefaultHarness        *string           `toml:"default_harness"`
+	DefaultModel          *string           `toml:"default_model"`
+	Defaults              *defaultsConfig   `toml:"defaults"`
+	WIPLimit              *int              `toml:"wip_limit"`
+	MaxRevisions          *int              `toml:"max_revisions"`
+	PlanningMaxIterations *int              `toml:"planning_max_iterations"`
+	StuckTimeout          *string           `toml:"stuck_timeout"`
+	HeartbeatInterval     *string           `toml:"heartbeat_interval"`
+	GateTimeout           *string           `toml:"gate_timeout"`
+	LogMaxSizeMB          *int              `toml:"log_max_size_mb"`
+	LogMaxFiles           *int              `toml:"log_max_files"`
+	HarnessLabels         map[string]string `toml:"harness_labels"`
 }
 
 type defaultsConfig struct {
@@ -151,6 +154,9 @@ func overlayFromFile(cfg *Config, path string) error {
 	if err := overlayRoleConfigs(cfg, raw, path); err != nil {
 		return err
 	}
+	if err := applyHarnessLabels(cfg, decoded, path); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -172,6 +178,18 @@ func (c *Config) ResolveHarnessModel(
 	role string,
 	domain string,
 	availability map[string]bool,
+) (string, string, []string, error) {
+	return c.ResolveHarnessModelWithOverride(role, domain, "", availability)
+}
+
+// ResolveHarnessModelWithOverride resolves harness/model like ResolveHarnessModel, except that a
+// non-empty harnessOverride, such as a mission's pinned or label-mapped harness, takes
+// precedence over the configured harness. Availability fallback still applies.
+func (c *Config) ResolveHarnessModelWithOverride(
+	role string,
+	domain string,
+	harnessOverride string,
+	availability map[string]bool,
 ) (string, string, []string, error) {
 	if c == nil {
 		return "", "", nil, errors.New("config must not be nil")
@@ -203,6 +221,10 @@ func (c *Config) ResolveHarnessModel(
 		}
 	}
 
+	if override := normalizeHarness(harnessOverride); override != "" {
+		selectedHarness = override
+	}
+
 	warnings := []string{}
 	if len(availability) == 0 {
 		return selectedHarness, selectedModel, warnings, nil
@@ -223,6 +245,24 @@ func (c *Config) ResolveHarnessModel(
 	return fallback, selectedModel, warnings, nil
 }
 
+// HarnessForLabels returns the harness mapped to the first of labels found in mapping,
+// or "" when none match. Labels are matched case-insensitively.
+func HarnessForLabels(mapping map[string]string, labels []string) string {
+	if len(mapping) == 0 {
+		return ""
+	}
+	for _, label := range labels {
+		for key, harnessName := range mapping {
+			if normalizeKey(key) == normalizeKey(label) {
+				if selected := normalizeHarness(harnessName); selected != "" {
+					return selected
+				}
+			}
+		}
+	}
+	return ""
+}
+
 func overlayRoleConfigs(cfg *Config, raw map[string]any, path string) error {
 	rolesRaw, ok := raw["roles"]
 	if !ok {
@@ -424,6 +464,27 @@ func applyLogOverrides(cfg *Config, decoded fileConfig, path string) error {
 	return nil
 }
 
+func applyHarnessLabels(cfg *Config, decoded fileConfig, path string) error {
+	if len(decoded.HarnessLabels) == 0 {
+		return nil
+	}
+	if cfg.HarnessLabels == nil {
+		cfg.HarnessLabels = map[string]string{}
+	}
+	for label, harnessName := range decoded.HarnessLabels {
+		key := normalizeKey(label)
+		if key == "" {
+			return fmt.Errorf("parse harness_labels in %q: label must not be empty", path)
+		}
+		selected := normalizeHarness(harnessName)
+		if selected == "" {
+			return fmt.Errorf("parse harness_labels.%s in %q: harness must not be empty", label, path)
+		}
+		cfg.HarnessLabels[key] = selected
+	}
+	return nil
+}
+
 func normalizeKey(value string) string {
 	return strings.ToLower(strings.TrimSpace(value))
 }
@@ -131,6 +131,38 @@ log_max_files = 7
 	}
 }
 
+func TestLoadHarnessLabels(t *testing.T) {
+	home := t.TempDir()
+	work := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(work)
+
+	writeFile(t, filepath.Join(home, ".sc3", "config.toml"), `
+[harness_labels]
+Frontend = "Codex"
+backend = "claude"
+	`)
+	writeFile(t, filepath.Join(work, ".sc3", "config.toml"), `
+[harness_labels]
+backend = "opencode"
+	`)
+
+	cfg, err := Load(context.Background())
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	if got := HarnessForLabels(cfg.HarnessLabels, []string{"FRONTEND"}); got != "codex" {
+		t.Fatalf("frontend harness = %q, want codex", got)
+	}
+	if got := HarnessForLabels(cfg.HarnessLabels, []string{"infra", "backend"}); got != "opencode" {
+		t.Fatalf("backend harness = %q, want project override opencode", got)
+	}
+	if got := HarnessForLabels(cfg.HarnessLabels, []string{"docs"}); got != "" {
+		t.Fatalf("unmapped harness = %q, want empty", got)
+	}
+}
+
 func TestLoadRoleAndDomainHarnessModelConfig(t *testing.T) {
 	home := t.TempDir()
 	work := t.TempDir()
@@ -238,6 +270,33 @@ func TestResolveHarnessModelPriorityAndFallback(t *testing.T) {
 	}
 }
 
+func TestResolveHarnessModelWithOverridePrefersOverrideHarness(t *testing.T) {
+	cfg := defaults()
+	cfg.DefaultHarness = "claude"
+	cfg.DefaultModel = "sonnet"
+
+	harnessName, modelName, warnings, err := cfg.ResolveHarnessModelWithOverride("ensign", "", "Codex", nil)
+	if err != nil {
+		t.Fatalf("resolve override: %v", err)
+	}
+	if harnessName != "codex" || modelName != "sonnet" || len(warnings) != 0 {
+		t.Fatalf("resolved = %q/%q %v, want codex/sonnet without warnings", harnessName, modelName, warnings)
+	}
+
+	harnessName, _, warnings, err = cfg.ResolveHarnessModelWithOverride(
+		"ensign",
+		"",
+		"codex",
+		map[string]bool{"claude": true, "codex": false},
+	)
+	if err != nil {
+		t.Fatalf("resolve unavailable override: %v", err)
+	}
+	if harnessName != "claude" || len(warnings) != 1 {
+		t.Fatalf("fallback = %q %v, want claude with one warning", harnessName, warnings)
+	}
+}
+
 func writeFile(t *testing.T, path, content string) {
 	t.Helper()
 
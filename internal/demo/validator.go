@@ -18,9 +18,16 @@ const (
 	ClassificationREDAlert = "RED_ALERT"
 	// ClassificationStandardOps requires at least one evidence section.
 	ClassificationStandardOps = "STANDARD_OPS"
+	// DefaultTokenPathTemplate is the demo token location used when no candidate paths are
+	// configured. "{mission_id}" is replaced with the mission ID.
+	DefaultTokenPathTemplate = "demo/MISSION-{mission_id}.md"
 )
 
-var backtickPathPattern = regexp.MustCompile("`([^`]+)`")
+var (
+	backtickPathPattern = regexp.MustCompile("`([^`]+)`")
+	markdownLinkPattern = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	fenceAttrPattern    = regexp.MustCompile(`(?:title|file)=["']?([^"'\s]+)`)
+)
 
 // Mission identifies the mission whose demo token must be validated.
 type Mission struct {
@@ -36,16 +43,45 @@ type ValidationResult struct {
 }
 
 // Validator validates demo token files against the V1 schema rules.
-type Validator struct{}
+type Validator struct {
+	checkReferences bool
+	tokenPaths      []string
+}
+
+// Option customizes a Validator.
+type Option func(*Validator)
+
+// WithReferenceCheck makes the validator verify that relative Markdown link targets and
+// code-fence file paths in the token body exist within the worktree.
+func WithReferenceCheck() Option {
+	return func(v *Validator) {
+		v.checkReferences = true
+	}
+}
+
+// WithTokenPaths sets the ordered candidate token path templates, relative to the worktree,
+// for teams migrating between token layouts. The first candidate that exists is validated.
+func WithTokenPaths(templates ...string) Option {
+	return func(v *Validator) {
+		v.tokenPaths = append([]string(nil), templates...)
+	}
+}
 
 // NewValidator creates a demo token validator.
-func NewValidator() *Validator {
-	return &Validator{}
+func NewValidator(opts ...Option) *Validator {
+	v := &Validator{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(v)
+		}
+	}
+	return v
 }
 
-// Validate checks demo/MISSION-<id>.md in the mission worktree and returns pass/fail evidence.
+// Validate checks demo/MISSION-<id>.md, or the first configured candidate path that exists,
+// in the mission worktree and returns pass/fail evidence.
 func (v *Validator) Validate(ctx context.Context, mission Mission, worktreePath string) ValidationResult {
-	tokenPath, err := tokenPathForMission(worktreePath, mission.ID)
+	tokenPath, err := v.tokenPathForMission(worktreePath, mission.ID)
 	if err != nil {
 		return failResult("", err.Error())
 	}
@@ -71,6 +107,11 @@ func (v *Validator) Validate(ctx context.Context, mission Mission, worktreePath
 	if !ok {
 		return diffResult
 	}
+	if v.checkReferences {
+		if refResult, ok := validateReferences(body, worktreePath, tokenPath); !ok {
+			return refResult
+		}
+	}
 
 	return validateEvidenceRequirements(sections, classification, hasDiffRefs, tokenPath)
 }
@@ -83,7 +124,7 @@ func failResult(tokenPath, reason string) ValidationResult {
 	}
 }
 
-func tokenPathForMission(worktreePath, missionID string) (string, error) {
+func (v *Validator) tokenPathForMission(worktreePath, missionID string) (string, error) {
 	trimmedWorktree := strings.TrimSpace(worktreePath)
 	if trimmedWorktree == "" {
 		return "", fmt.Errorf("worktree path must not be empty")
@@ -95,7 +136,42 @@ func tokenPathForMission(worktreePath, missionID string) (string, error) {
 	if strings.Contains(trimmedMissionID, "/") || strings.Contains(trimmedMissionID, "\\") {
 		return "", fmt.Errorf("mission id must not contain path separators")
 	}
-	return filepath.Join(trimmedWorktree, "demo", "MISSION-"+trimmedMissionID+".md"), nil
+	return ResolveTokenPath(trimmedWorktree, trimmedMissionID, v.tokenPaths)
+}
+
+// ResolveTokenPath expands each candidate path template for the mission and returns the
+// first that exists. When none exists it returns the first candidate, so a missing-token
+// error names the primary location. Every candidate must stay inside the worktree; empty
+// templates fall back to DefaultTokenPathTemplate.
+func ResolveTokenPath(worktreePath, missionID string, templates []string) (string, error) {
+	root := filepath.Clean(worktreePath)
+	if strings.TrimSpace(worktreePath) == "" || root == "." {
+		return "", errors.New("worktree path must not be empty")
+	}
+	if len(templates) == 0 {
+		templates = []string{DefaultTokenPathTemplate}
+	}
+
+	candidates := make([]string, 0, len(templates))
+	rootWithSep := root + string(os.PathSeparator)
+	for _, template := range templates {
+		relative := strings.ReplaceAll(strings.TrimSpace(template), "{mission_id}", missionID)
+		if relative == "" {
+			return "", errors.New("demo token path template must not be empty")
+		}
+		candidate := filepath.Clean(filepath.Join(root, relative))
+		if !strings.HasPrefix(candidate, rootWithSep) {
+			return "", fmt.Errorf("demo token path escapes worktree root: %s", candidate)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return candidates[0], nil
 }
 
 func readTokenFile(tokenPath string) (string, ValidationResult, bool) {
@@ -184,6 +260,86 @@ func validateDiffRefs(sections map[string]string, worktreePath, tokenPath string
 	return true, ValidationResult{}, true
 }
 
+// validateReferences checks that every file the token body points at exists in the worktree.
+func validateReferences(body, worktreePath, tokenPath string) (ValidationResult, bool) {
+	for _, ref := range parseReferences(body) {
+		cleanPath, err := safeRelativePath(ref)
+		if err != nil {
+			return failResult(tokenPath, fmt.Sprintf("invalid file reference %q: %v", ref, err)), false
+		}
+		if _, err := os.Stat(filepath.Join(worktreePath, cleanPath)); err != nil {
+			if os.IsNotExist(err) {
+				return failResult(tokenPath, fmt.Sprintf("demo token references nonexistent file %s", cleanPath)), false
+			}
+			return failResult(tokenPath, fmt.Sprintf("check file reference %s: %v", cleanPath, err)), false
+		}
+	}
+	return ValidationResult{}, true
+}
+
+// parseReferences returns local file targets of Markdown links and code fences whose info
+// string names a file (```go path/to/file.go, ```go:path/to/file.go, or title=/file=).
+// URLs and in-page anchors are skipped.
+func parseReferences(body string) []string {
+	normalized := strings.ReplaceAll(body, "\r\n", "\n")
+	var refs []string
+	inFence := false
+	for _, line := range strings.Split(normalized, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			if !inFence {
+				if ref := fencePath(strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))); ref != "" {
+					refs = append(refs, ref)
+				}
+			}
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, match := range markdownLinkPattern.FindAllStringSubmatch(line, -1) {
+			if target := localLinkTarget(match[1]); target != "" {
+				refs = append(refs, target)
+			}
+		}
+	}
+	return refs
+}
+
+func fencePath(info string) string {
+	if info == "" {
+		return ""
+	}
+	if matches := fenceAttrPattern.FindStringSubmatch(info); len(matches) == 2 {
+		return matches[1]
+	}
+	if _, path, ok := strings.Cut(info, ":"); ok && looksLikePath(path) {
+		return path
+	}
+	if fields := strings.Fields(info); len(fields) >= 2 && looksLikePath(fields[1]) {
+		return fields[1]
+	}
+	return ""
+}
+
+func looksLikePath(candidate string) bool {
+	return candidate != "" &&
+		strings.ContainsAny(candidate, "/.") &&
+		!strings.ContainsAny(candidate, " \t={}")
+}
+
+func localLinkTarget(target string) string {
+	target = strings.Trim(strings.TrimSpace(target), "<>")
+	if target == "" || strings.HasPrefix(target, "#") || strings.Contains(target, "://") || strings.HasPrefix(target, "mailto:") {
+		return ""
+	}
+	if idx := strings.IndexAny(target, "#?"); idx >= 0 {
+		target = target[:idx]
+	}
+	return target
+}
+
 func validateEvidenceRequirements(
 	sections map[string]string,
 	classification string,
@@ -251,6 +251,153 @@ func TestValidatorValidate(t *testing.T) {
 	}
 }
 
+func TestValidatorReferenceCheck(t *testing.T) {
+	t.Parallel()
+
+	mission := Mission{ID: "MISSION-42", Classification: ClassificationStandardOps}
+	token := func(lines ...string) string {
+		body := append([]string{"### manual_steps", "1. Follow the runbook."}, lines...)
+		return tokenMarkdown(mission.ID, ClassificationStandardOps, body, nil)
+	}
+
+	tests := []struct {
+		name               string
+		opts               []Option
+		tokenContent       string
+		worktreeFiles      map[string]string
+		wantValid          bool
+		wantReasonContains string
+	}{
+		{
+			name:               "fails when a markdown link targets a missing file",
+			opts:               []Option{WithReferenceCheck()},
+			tokenContent:       token("2. See [runbook](docs/runbook.md#setup)."),
+			wantValid:          false,
+			wantReasonContains: "references nonexistent file docs/runbook.md",
+		},
+		{
+			name: "fails when a code fence names a missing file",
+			opts: []Option{WithReferenceCheck()},
+			tokenContent: token(
+				"```go internal/demo/missing.go",
+				"package demo",
+				"```",
+			),
+			wantValid:          false,
+			wantReasonContains: "internal/demo/missing.go",
+		},
+		{
+			name:               "fails when a reference escapes the worktree",
+			opts:               []Option{WithReferenceCheck()},
+			tokenContent:       token("2. Compare with [secrets](../outside.md)."),
+			wantValid:          false,
+			wantReasonContains: "path escapes worktree",
+		},
+		{
+			name: "passes when references exist and URLs are skipped",
+			opts: []Option{WithReferenceCheck()},
+			tokenContent: token(
+				"2. See [runbook](docs/runbook.md \"Runbook\") and [upstream](https://example.com/x.md).",
+				"```go title=internal/demo/validator.go",
+				"package demo",
+				"```",
+				"```bash",
+				"go test [x](not/a/link.md)",
+				"```",
+			),
+			worktreeFiles: map[string]string{
+				"docs/runbook.md":            "# Runbook\n",
+				"internal/demo/validator.go": "package demo\n",
+			},
+			wantValid: true,
+		},
+		{
+			name:         "ignores missing references without the option",
+			tokenContent: token("2. See [runbook](docs/runbook.md)."),
+			wantValid:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			for relPath, contents := range tt.worktreeFiles {
+				absPath := filepath.Join(root, relPath)
+				require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o750))
+				require.NoError(t, os.WriteFile(absPath, []byte(contents), 0o600))
+			}
+			writeDemoToken(t, root, mission.ID, tt.tokenContent)
+
+			result := NewValidator(tt.opts...).Validate(context.Background(), mission, root)
+
+			assert.Equal(t, tt.wantValid, result.Valid, result.Reason)
+			if !tt.wantValid {
+				assert.Contains(t, result.Reason, tt.wantReasonContains)
+			}
+		})
+	}
+}
+
+func TestValidatorTokenPathCandidates(t *testing.T) {
+	t.Parallel()
+
+	mission := Mission{ID: "MISSION-42", Classification: ClassificationStandardOps}
+	content := tokenMarkdown(mission.ID, ClassificationStandardOps, []string{"### manual_steps", "1. Follow the runbook."}, nil)
+	candidates := []string{"demo/MISSION-{mission_id}.md", "docs/demo/{mission_id}.md"}
+
+	t.Run("finds a token at the second candidate path", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		tokenPath := filepath.Join(root, "docs", "demo", mission.ID+".md")
+		require.NoError(t, os.MkdirAll(filepath.Dir(tokenPath), 0o750))
+		require.NoError(t, os.WriteFile(tokenPath, []byte(content), 0o600))
+
+		result := NewValidator(WithTokenPaths(candidates...)).Validate(context.Background(), mission, root)
+
+		assert.True(t, result.Valid, result.Reason)
+		assert.Equal(t, tokenPath, result.TokenPath)
+	})
+
+	t.Run("prefers the first candidate when both exist", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		writeDemoToken(t, root, mission.ID, content)
+		legacy := filepath.Join(root, "docs", "demo", mission.ID+".md")
+		require.NoError(t, os.MkdirAll(filepath.Dir(legacy), 0o750))
+		require.NoError(t, os.WriteFile(legacy, []byte(content), 0o600))
+
+		result := NewValidator(WithTokenPaths(candidates...)).Validate(context.Background(), mission, root)
+
+		assert.Equal(t, filepath.Join(root, "demo", "MISSION-"+mission.ID+".md"), result.TokenPath)
+	})
+
+	t.Run("reports the first candidate when none exist", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		result := NewValidator(WithTokenPaths(candidates...)).Validate(context.Background(), mission, root)
+
+		assert.False(t, result.Valid)
+		assert.Equal(t, filepath.Join(root, "demo", "MISSION-"+mission.ID+".md"), result.TokenPath)
+	})
+
+	t.Run("rejects a candidate that escapes the worktree", func(t *testing.T) {
+		t.Parallel()
+
+		root := t.TempDir()
+		writeDemoToken(t, root, mission.ID, content)
+		result := NewValidator(WithTokenPaths(candidates[0], "../{mission_id}.md")).Validate(context.Background(), mission, root)
+
+		assert.False(t, result.Valid)
+		assert.Contains(t, result.Reason, "escapes worktree root")
+	})
+}
+
 func writeDemoToken(t *testing.T, worktreeRoot, missionID, content string) {
 	t.Helper()
 	tokenPath := filepath.Join(worktreeRoot, "demo", "MISSION-"+missionID+".md")
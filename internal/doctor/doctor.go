@@ -13,6 +13,7 @@ import (
 const (
 	defaultHeartbeatInterval = 30 * time.Second
 	defaultStuckTimeout      = 5 * time.Minute
+	defaultRestartTimeout    = time.Minute
 )
 
 const (
@@ -29,6 +30,8 @@ type Mission struct {
 	ID      string
 	State   string
 	AgentID string
+	// StartedAt is when the mission was dispatched to its agent.
+	StartedAt time.Time
 }
 
 // Agent is the subset of agent state required by Doctor monitoring.
@@ -50,6 +53,8 @@ type StateStore interface {
 	LoadSnapshot(ctx context.Context) (Snapshot, error)
 	SetMissionBacklog(ctx context.Context, missionID string) error
 	SetAgentStuck(ctx context.Context, agentID string) error
+	// ClearAgentStuck returns a successfully restarted agent to the running state.
+	ClearAgentStuck(ctx context.Context, agentID string) error
 }
 
 // SessionManager resolves active tmux sessions and cleans zombie sessions.
@@ -58,6 +63,12 @@ type SessionManager interface {
 	CleanupDeadSession(ctx context.Context, sessionID string) error
 }
 
+// AgentRestarter relaunches an agent the Doctor marked stuck so its work resumes without
+// a human re-dispatching it.
+type AgentRestarter interface {
+	Restart(ctx context.Context, agentID string) error
+}
+
 // EventBus publishes health and transition events.
 type EventBus interface {
 	Publish(event events.Event)
@@ -67,6 +78,19 @@ type EventBus interface {
 type Config struct {
 	HeartbeatInterval time.Duration
 	StuckTimeout      time.Duration
+	// StartupGrace excludes agents whose mission started within this window from
+	// stuck detection, since freshly dispatched agents have not heartbeated yet.
+	// Zero disables the grace period.
+	StartupGrace time.Duration
+	// Restarter, when set, is asked to restart each agent as soon as it is marked stuck.
+	// Nil keeps the mark-and-backlog behavior and leaves relaunching to a human.
+	Restarter AgentRestarter
+	// RestartTimeout bounds each Restarter call so one hung relaunch cannot stall the
+	// heartbeat. Zero uses a one-minute default.
+	RestartTimeout time.Duration
+	// Now supplies the wall clock for heartbeat staleness and event timestamps; nil uses
+	// time.Now. Tests inject a fixed clock to check stuck detection at exact offsets.
+	Now func() time.Time
 }
 
 // HealthReport is emitted on every Doctor heartbeat.
@@ -75,6 +99,8 @@ type HealthReport struct {
 	StuckAgents      int       `json:"stuck_agents"`
 	OrphanedMissions int       `json:"orphaned_missions"`
 	ZombieSessions   int       `json:"zombie_sessions"`
+	RestartedAgents  int       `json:"restarted_agents"`
+	RestartFailures  int       `json:"restart_failures"`
 	DoctorHeartbeat  time.Time `json:"doctor_heartbeat"`
 }
 
@@ -85,6 +111,9 @@ type Manager struct {
 	bus               EventBus
 	heartbeatInterval time.Duration
 	stuckTimeout      time.Duration
+	startupGrace      time.Duration
+	restarter         AgentRestarter
+	restartTimeout    time.Duration
 	now               func() time.Time
 	newTicker         func(time.Duration) *time.Ticker
 }
@@ -106,13 +135,22 @@ func NewManager(store StateStore, sessions SessionManager, bus EventBus, cfg Con
 	if cfg.StuckTimeout <= 0 {
 		cfg.StuckTimeout = defaultStuckTimeout
 	}
+	if cfg.RestartTimeout <= 0 {
+		cfg.RestartTimeout = defaultRestartTimeout
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
 	return &Manager{
 		store:             store,
 		sessions:          sessions,
 		bus:               bus,
 		heartbeatInterval: cfg.HeartbeatInterval,
 		stuckTimeout:      cfg.StuckTimeout,
-		now:               time.Now,
+		startupGrace:      cfg.StartupGrace,
+		restarter:         cfg.Restarter,
+		restartTimeout:    cfg.RestartTimeout,
+		now:               cfg.Now,
 		newTicker:         time.NewTicker,
 	}, nil
 }
@@ -166,14 +204,18 @@ func (m *Manager) RunOnce(ctx context.Context) (HealthReport, error) {
 		DoctorHeartbeat: now,
 	}
 
-	agentByID, knownSessions, activeAgents, stuckAgents, err := m.processAgents(ctx, snapshot.Agents, now)
+	agentByID, knownSessions, restarted, err := m.processAgents(
+		ctx,
+		snapshot.Agents,
+		m.agentsInStartupGrace(snapshot.Missions, now),
+		now,
+		&report,
+	)
 	if err != nil {
 		return HealthReport{}, err
 	}
-	report.ActiveAgents = activeAgents
-	report.StuckAgents = stuckAgents
 
-	orphanedMissions, err := m.repairOrphanedMissions(ctx, snapshot.Missions, agentByID, activeSessions)
+	orphanedMissions, err := m.repairOrphanedMissions(ctx, snapshot.Missions, agentByID, activeSessions, restarted)
 	if err != nil {
 		return HealthReport{}, err
 	}
@@ -200,12 +242,13 @@ func (m *Manager) RunOnce(ctx context.Context) (HealthReport, error) {
 func (m *Manager) processAgents(
 	ctx context.Context,
 	agents []Agent,
+	inGrace map[string]struct{},
 	now time.Time,
-) (map[string]Agent, map[string]struct{}, int, int, error) {
+	report *HealthReport,
+) (map[string]Agent, map[string]struct{}, map[string]struct{}, error) {
 	agentByID := map[string]Agent{}
 	knownSessions := map[string]struct{}{}
-	activeCount := 0
-	stuckCount := 0
+	restarted := map[string]struct{}{}
 
 	for _, agent := range agents {
 		agentByID[strings.TrimSpace(agent.ID)] = agent
@@ -214,23 +257,89 @@ func (m *Manager) processAgents(
 			knownSessions[sessionID] = struct{}{}
 		}
 		if isActiveAgentState(agent.State) {
-			activeCount++
+			report.ActiveAgents++
 		}
 		if strings.EqualFold(strings.TrimSpace(agent.State), agentStuck) {
-			stuckCount++
+			report.StuckAgents++
+			continue
+		}
+		if _, ok := inGrace[strings.TrimSpace(agent.ID)]; ok {
 			continue
 		}
 		if !shouldTransitionToStuck(agent, now, m.stuckTimeout) {
 			continue
 		}
 		if err := m.store.SetAgentStuck(ctx, agent.ID); err != nil {
-			return nil, nil, 0, 0, fmt.Errorf("set agent %s stuck: %w", agent.ID, err)
+			return nil, nil, nil, fmt.Errorf("set agent %s stuck: %w", agent.ID, err)
 		}
-		stuckCount++
+		report.StuckAgents++
 		m.publishStuckTransition(agent, now)
+		if m.restartStuckAgent(ctx, agent, now, report) {
+			restarted[strings.TrimSpace(agent.ID)] = struct{}{}
+		}
 	}
 
-	return agentByID, knownSessions, activeCount, stuckCount, nil
+	return agentByID, knownSessions, restarted, nil
+}
+
+// restartStuckAgent asks the configured restarter to relaunch a newly stuck agent, clears
+// its stuck state once relaunched, and records the outcome. A failed restart is reported,
+// not fatal: the agent stays stuck and its mission is still backlogged.
+func (m *Manager) restartStuckAgent(ctx context.Context, agent Agent, now time.Time, report *HealthReport) bool {
+	if m.restarter == nil {
+		return false
+	}
+
+	payload := map[string]string{"action": "restart", "result": "restarted"}
+	severity := events.SeverityInfo
+	err := m.restart(ctx, agent.ID)
+	if err == nil {
+		if clearErr := m.store.ClearAgentStuck(ctx, agent.ID); clearErr != nil {
+			err = fmt.Errorf("clear stuck state: %w", clearErr)
+		}
+	}
+	if err != nil {
+		report.RestartFailures++
+		payload["result"] = "failed"
+		payload["error"] = err.Error()
+		severity = events.SeverityWarn
+	} else {
+		report.RestartedAgents++
+	}
+
+	m.bus.Publish(events.Event{
+		Type:       events.EventTypeHealthCheck,
+		Timestamp:  now,
+		EntityType: "agent",
+		EntityID:   agent.ID,
+		Payload:    payload,
+		Severity:   severity,
+	})
+	return err == nil
+}
+
+func (m *Manager) restart(ctx context.Context, agentID string) error {
+	restartCtx, cancel := context.WithTimeout(ctx, m.restartTimeout)
+	defer cancel()
+	return m.restarter.Restart(restartCtx, agentID)
+}
+
+// agentsInStartupGrace returns agents whose mission was dispatched within the startup grace window.
+func (m *Manager) agentsInStartupGrace(missions []Mission, now time.Time) map[string]struct{} {
+	inGrace := map[string]struct{}{}
+	if m.startupGrace <= 0 {
+		return inGrace
+	}
+	for _, mission := range missions {
+		agentID := strings.TrimSpace(mission.AgentID)
+		if agentID == "" || mission.StartedAt.IsZero() {
+			continue
+		}
+		if now.Sub(mission.StartedAt.UTC()) < m.startupGrace {
+			inGrace[agentID] = struct{}{}
+		}
+	}
+	return inGrace
 }
 
 func (m *Manager) publishStuckTransition(agent Agent, now time.Time) {
@@ -252,12 +361,17 @@ func (m *Manager) repairOrphanedMissions(
 	missions []Mission,
 	agentByID map[string]Agent,
 	activeSessions map[string]struct{},
+	restarted map[string]struct{},
 ) (int, error) {
 	orphanedCount := 0
 	for _, mission := range missions {
 		if !strings.EqualFold(strings.TrimSpace(mission.State), missionInProgress) {
 			continue
 		}
+		if _, ok := restarted[strings.TrimSpace(mission.AgentID)]; ok {
+			// The snapshot predates the restart; the relaunched agent owns the mission again.
+			continue
+		}
 		if !missionHasLiveSession(mission, agentByID, activeSessions) {
 			if err := m.store.SetMissionBacklog(ctx, mission.ID); err != nil {
 				return 0, fmt.Errorf("set orphaned mission %s backlog: %w", mission.ID, err)
@@ -107,6 +107,48 @@ func TestRunOnceDetectsStuckAgentsOrphansAndZombies(t *testing.T) {
 	}
 }
 
+func TestRunOnceSkipsStuckDetectionDuringStartupGrace(t *testing.T) {
+	dispatchedAt := time.Date(2026, 2, 11, 8, 30, 0, 0, time.UTC)
+	store := &fakeStateStore{
+		snapshot: Snapshot{
+			Agents: []Agent{
+				{ID: "agent-fresh", State: agentSpawning, SessionID: "session-fresh"},
+			},
+			Missions: []Mission{
+				{ID: "mission-fresh", State: missionInProgress, AgentID: "agent-fresh", StartedAt: dispatchedAt},
+			},
+		},
+	}
+	sessions := &fakeSessionManager{activeSessions: map[string]struct{}{"session-fresh": {}}}
+	bus := &fakeEventBus{}
+
+	manager, err := NewManager(store, sessions, bus, Config{
+		StuckTimeout: 5 * time.Minute,
+		StartupGrace: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	manager.now = func() time.Time { return dispatchedAt.Add(10 * time.Second) }
+	report, err := manager.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("run once within grace: %v", err)
+	}
+	if report.StuckAgents != 0 || len(store.setAgentStuck) != 0 {
+		t.Fatalf("stuck agents within grace = %d (%v), want none", report.StuckAgents, store.setAgentStuck)
+	}
+
+	manager.now = func() time.Time { return dispatchedAt.Add(2 * time.Minute) }
+	report, err = manager.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("run once after grace: %v", err)
+	}
+	if report.StuckAgents != 1 || !reflect.DeepEqual(store.setAgentStuck, []string{"agent-fresh"}) {
+		t.Fatalf("stuck agents after grace = %d (%v), want [agent-fresh]", report.StuckAgents, store.setAgentStuck)
+	}
+}
+
 func TestStartRunsUntilCancelled(t *testing.T) {
 	store := &fakeStateStore{
 		snapshot: Snapshot{
@@ -161,11 +203,152 @@ func TestRunOncePropagatesStoreErrors(t *testing.T) {
 	}
 }
 
+func TestRunOnceRestartsStuckAgentsWhenRestarterConfigured(t *testing.T) {
+	now := time.Date(2026, 2, 11, 8, 30, 0, 0, time.UTC)
+	store := &fakeStateStore{
+		snapshot: Snapshot{
+			Agents: []Agent{
+				{ID: "agent-ok", State: agentRunning, SessionID: "session-ok", LastHeartbeat: now.Add(-10 * time.Minute)},
+				{ID: "agent-broken", State: agentRunning, SessionID: "session-broken", LastHeartbeat: now.Add(-10 * time.Minute)},
+				{ID: "agent-live", State: agentRunning, SessionID: "session-live", LastHeartbeat: now.Add(-time.Minute)},
+			},
+			Missions: []Mission{
+				{ID: "mission-ok", State: missionInProgress, AgentID: "agent-ok"},
+				{ID: "mission-broken", State: missionInProgress, AgentID: "agent-broken"},
+			},
+		},
+	}
+	sessions := &fakeSessionManager{activeSessions: map[string]struct{}{"session-live": {}}}
+	bus := &fakeEventBus{}
+	restarter := &fakeAgentRestarter{errs: map[string]error{"agent-broken": errors.New("tmux unavailable")}}
+
+	manager, err := NewManager(store, sessions, bus, Config{
+		StuckTimeout: 5 * time.Minute,
+		Restarter:    restarter,
+		Now:          func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	report, err := manager.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("run once: %v", err)
+	}
+
+	if !reflect.DeepEqual(store.setAgentStuck, []string{"agent-ok", "agent-broken"}) {
+		t.Fatalf("setAgentStuck = %v, want both stale agents marked before restart", store.setAgentStuck)
+	}
+	if !reflect.DeepEqual(restarter.restarted, []string{"agent-ok", "agent-broken"}) {
+		t.Fatalf("restart attempts = %v, want [agent-ok agent-broken]", restarter.restarted)
+	}
+	if report.StuckAgents != 2 || report.RestartedAgents != 1 || report.RestartFailures != 1 {
+		t.Fatalf("report = %+v, want 2 stuck, 1 restarted, 1 restart failure", report)
+	}
+	if !reflect.DeepEqual(store.clearedStuck, []string{"agent-ok"}) {
+		t.Fatalf("clearedStuck = %v, want only the restarted agent returned to running", store.clearedStuck)
+	}
+	if !reflect.DeepEqual(store.setMissionBacklog, []string{"mission-broken"}) {
+		t.Fatalf("backlogged missions = %v, want only the mission whose agent failed to restart", store.setMissionBacklog)
+	}
+	if report.OrphanedMissions != 1 {
+		t.Fatalf("orphaned missions = %d, want 1", report.OrphanedMissions)
+	}
+	if !restarter.deadlines["agent-ok"] {
+		t.Fatal("restart context had no deadline, want it bounded by RestartTimeout")
+	}
+
+	restartEvents := map[string]string{}
+	for _, event := range bus.events {
+		if event.Type != events.EventTypeHealthCheck || event.EntityType != "agent" {
+			continue
+		}
+		payload, ok := event.Payload.(map[string]string)
+		if !ok {
+			t.Fatalf("restart event payload = %T, want map[string]string", event.Payload)
+		}
+		restartEvents[event.EntityID] = payload["result"]
+	}
+	if !reflect.DeepEqual(restartEvents, map[string]string{"agent-ok": "restarted", "agent-broken": "failed"}) {
+		t.Fatalf("restart health events = %v", restartEvents)
+	}
+}
+
+func TestRunOnceBoundsHungRestartAndBacklogsItsMission(t *testing.T) {
+	now := time.Date(2026, 2, 11, 8, 30, 0, 0, time.UTC)
+	store := &fakeStateStore{
+		snapshot: Snapshot{
+			Agents:   []Agent{{ID: "agent-hung", State: agentRunning, SessionID: "session-hung", LastHeartbeat: now.Add(-10 * time.Minute)}},
+			Missions: []Mission{{ID: "mission-hung", State: missionInProgress, AgentID: "agent-hung"}},
+		},
+	}
+	restarter := &fakeAgentRestarter{block: true}
+
+	manager, err := NewManager(store, &fakeSessionManager{}, &fakeEventBus{}, Config{
+		StuckTimeout:   5 * time.Minute,
+		Restarter:      restarter,
+		RestartTimeout: 10 * time.Millisecond,
+		Now:            func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	started := time.Now()
+	report, err := manager.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("run once: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("run once took %s, want the hung restart cut off by RestartTimeout", elapsed)
+	}
+	if report.RestartedAgents != 0 || report.RestartFailures != 1 {
+		t.Fatalf("report = %+v, want the hung restart counted as a failure", report)
+	}
+	if len(store.clearedStuck) != 0 {
+		t.Fatalf("clearedStuck = %v, want the agent left stuck", store.clearedStuck)
+	}
+	if !reflect.DeepEqual(store.setMissionBacklog, []string{"mission-hung"}) {
+		t.Fatalf("backlogged missions = %v, want [mission-hung]", store.setMissionBacklog)
+	}
+}
+
+func TestRunOnceWithoutRestarterOnlyMarksStuckAgents(t *testing.T) {
+	now := time.Date(2026, 2, 11, 8, 30, 0, 0, time.UTC)
+	store := &fakeStateStore{
+		snapshot: Snapshot{
+			Agents: []Agent{{ID: "agent-stale", State: agentRunning, SessionID: "session-stale", LastHeartbeat: now.Add(-10 * time.Minute)}},
+		},
+	}
+	bus := &fakeEventBus{}
+
+	manager, err := NewManager(store, &fakeSessionManager{}, bus, Config{
+		StuckTimeout: 5 * time.Minute,
+		Now:          func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	report, err := manager.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("run once: %v", err)
+	}
+	if report.StuckAgents != 1 || report.RestartedAgents != 0 || report.RestartFailures != 0 {
+		t.Fatalf("report = %+v, want 1 stuck and no restarts", report)
+	}
+	if count := bus.countByType(events.EventTypeHealthCheck); count != 1 {
+		t.Fatalf("health check events = %d, want only the heartbeat report", count)
+	}
+}
+
 type fakeStateStore struct {
 	snapshot          Snapshot
 	loadSnapshotErr   error
 	setMissionBacklog []string
 	setAgentStuck     []string
+	clearedStuck      []string
+	clearStuckErr     error
 }
 
 func (f *fakeStateStore) LoadSnapshot(context.Context) (Snapshot, error) {
@@ -185,6 +368,14 @@ func (f *fakeStateStore) SetAgentStuck(_ context.Context, agentID string) error
 	return nil
 }
 
+func (f *fakeStateStore) ClearAgentStuck(_ context.Context, agentID string) error {
+	if f.clearStuckErr != nil {
+		return f.clearStuckErr
+	}
+	f.clearedStuck = append(f.clearedStuck, agentID)
+	return nil
+}
+
 type fakeSessionManager struct {
 	activeSessions    map[string]struct{}
 	activeSessionsErr error
@@ -229,3 +420,24 @@ func (f *fakeEventBus) countByType(eventType string) int {
 	}
 	return count
 }
+
+type fakeAgentRestarter struct {
+	errs      map[string]error
+	restarted []string
+	deadlines map[string]bool
+	// block makes Restart wait for its context to end.
+	block bool
+}
+
+func (f *fakeAgentRestarter) Restart(ctx context.Context, agentID string) error {
+	f.restarted = append(f.restarted, agentID)
+	if f.deadlines == nil {
+		f.deadlines = make(map[string]bool)
+	}
+	_, f.deadlines[agentID] = ctx.Deadline()
+	if f.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return f.errs[agentID]
+}
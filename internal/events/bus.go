@@ -2,6 +2,7 @@ package events
 
 import (
 	"log"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -10,6 +11,8 @@ import (
 const (
 	// DefaultBufferSize is the default per-subscriber channel capacity.
 	DefaultBufferSize = 100
+	// WildcardEventType labels SubscribeAll subscribers in diagnostic stats.
+	WildcardEventType = "*"
 
 	// EventTypeStateTransition identifies state transition events.
 	EventTypeStateTransition = "StateTransition"
@@ -48,6 +51,13 @@ type Event struct {
 	Severity   string
 }
 
+// SubscriberStat reports subscriber count and undelivered backlog for one event type.
+type SubscriberStat struct {
+	EventType   string
+	Subscribers int
+	Pending     int
+}
+
 // Handler consumes a published event.
 type Handler func(Event)
 
@@ -61,6 +71,9 @@ type Bus interface {
 	Subscribe(eventType string, handler Handler)
 	SubscribeAll(handler Handler)
 	Publish(event Event)
+	// SubscriberStats reports per-type subscriber counts and pending deliveries, sorted by
+	// event type, with wildcard subscribers under WildcardEventType.
+	SubscriberStats() []SubscriberStat
 }
 
 // Option customizes bus construction.
@@ -157,6 +170,36 @@ func (b *InMemoryBus) Publish(event Event) {
 	}
 }
 
+// SubscriberStats reports per-type subscriber counts and channel backlog in event-type order.
+// Wildcard subscribers are reported under WildcardEventType.
+func (b *InMemoryBus) SubscriberStats() []SubscriberStat {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	stats := make([]SubscriberStat, 0, len(b.typedSubs)+1)
+	for eventType, subs := range b.typedSubs {
+		if len(subs) == 0 {
+			continue
+		}
+		stats = append(stats, subscriberStat(eventType, subs))
+	}
+	if len(b.wildcardSubs) > 0 {
+		stats = append(stats, subscriberStat(WildcardEventType, b.wildcardSubs))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].EventType < stats[j].EventType
+	})
+	return stats
+}
+
+func subscriberStat(eventType string, subs []*subscriber) SubscriberStat {
+	stat := SubscriberStat{EventType: eventType, Subscribers: len(subs)}
+	for _, sub := range subs {
+		stat.Pending += len(sub.ch)
+	}
+	return stat
+}
+
 func (b *InMemoryBus) snapshotSubscribers(eventType string) ([]*subscriber, []*subscriber) {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -205,6 +205,48 @@ func TestBusSupportsConcurrentPublishAndSubscribe(t *testing.T) {
 	waitForCount(t, &received, expectedFromWildcard, 2*time.Second)
 }
 
+func TestSubscriberStatsReportsSubscribersAndBacklog(t *testing.T) {
+	t.Parallel()
+
+	var bus Bus = New(WithLogger(&captureLogger{}))
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{}, 3)
+	blockingHandler := func(Event) {
+		started <- struct{}{}
+		<-release
+	}
+
+	bus.Subscribe(EventTypeStateTransition, blockingHandler)
+	bus.Subscribe(EventTypeStateTransition, blockingHandler)
+	bus.SubscribeAll(blockingHandler)
+
+	for i := 0; i < 3; i++ {
+		bus.Publish(Event{Type: EventTypeStateTransition, EntityID: fmt.Sprintf("m-%d", i)})
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case <-started:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for subscribers to start handling")
+		}
+	}
+
+	stats := bus.SubscriberStats()
+	want := []SubscriberStat{
+		{EventType: WildcardEventType, Subscribers: 1, Pending: 2},
+		{EventType: EventTypeStateTransition, Subscribers: 2, Pending: 4},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("stats = %+v, want %+v", stats, want)
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Fatalf("stats[%d] = %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+}
+
 func containsType(types []string, want string) bool {
 	for _, eventType := range types {
 		if eventType == want {
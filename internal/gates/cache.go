@@ -0,0 +1,169 @@
+package gates
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// resultCache memoizes gate results for unchanged worktrees.
+//
+// Entries are keyed by mission, gate type, the substituted command list, and a
+// content hash of the worktree, so any source edit naturally misses the cache. Files
+// ignored by git, such as coverage reports and build output that gates write, are left
+// out of the hash.
+type resultCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]GateResult
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{entries: make(map[string]map[string]GateResult)}
+}
+
+func (c *resultCache) get(missionID, key string) (GateResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.entries[missionID][key]
+	return result, ok
+}
+
+func (c *resultCache) put(missionID, key string, result GateResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries[missionID] == nil {
+		c.entries[missionID] = make(map[string]GateResult)
+	}
+	c.entries[missionID][key] = result
+}
+
+func (c *resultCache) invalidate(missionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if missionID == "" {
+		c.entries = make(map[string]map[string]GateResult)
+		return
+	}
+	delete(c.entries, missionID)
+}
+
+// cacheKey derives the cache key for one gate invocation.
+func cacheKey(ctx context.Context, gateType string, commands []string, workdir string) (string, error) {
+	contentHash, err := hashWorktree(ctx, workdir)
+	if err != nil {
+		return "", err
+	}
+	return gateType + "\x00" + strings.Join(commands, "\x00") + "\x00" + contentHash, nil
+}
+
+// hashWorktree hashes file paths and contents under workdir. Inside a git checkout only
+// tracked and non-ignored untracked files count; elsewhere every regular file outside
+// VCS metadata does.
+func hashWorktree(ctx context.Context, workdir string) (string, error) {
+	paths, err := gitVisibleFiles(ctx, workdir)
+	if err != nil {
+		paths, err = walkRegularFiles(workdir)
+	}
+	if err != nil {
+		return "", fmt.Errorf("hash worktree %s: %w", workdir, err)
+	}
+
+	hasher := sha256.New()
+	for _, rel := range paths {
+		if err := hashFile(hasher, workdir, rel); err != nil {
+			return "", fmt.Errorf("hash worktree %s: %w", workdir, err)
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// gitVisibleFiles lists tracked and untracked, non-ignored files relative to workdir.
+func gitVisibleFiles(ctx context.Context, workdir string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-files", "-z", "--cached", "--others", "--exclude-standard")
+	cmd.Dir = workdir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{})
+	paths := make([]string, 0)
+	for _, rel := range strings.Split(string(out), "\x00") {
+		if rel == "" {
+			continue
+		}
+		if _, ok := seen[rel]; ok {
+			continue
+		}
+		seen[rel] = struct{}{}
+		paths = append(paths, filepath.FromSlash(rel))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// walkRegularFiles lists regular files under workdir in lexical order, skipping .git.
+func walkRegularFiles(workdir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(workdir, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(workdir, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, rel)
+		return nil
+	})
+	return paths, err
+}
+
+// hashFile writes one file's path and content to hasher. Tracked files deleted from the
+// worktree hash as a path with a deletion marker, so removing a file changes the key.
+func hashFile(hasher io.Writer, workdir, rel string) error {
+	fmt.Fprintf(hasher, "%s\x00", filepath.ToSlash(rel))
+	// #nosec G304 -- rel is listed from inside the worktree being verified.
+	file, err := os.Open(filepath.Join(workdir, rel))
+	if errors.Is(err, fs.ErrNotExist) {
+		_, _ = hasher.Write([]byte("\x01deleted\x00"))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Mode().IsRegular() {
+		if _, err := io.Copy(hasher, file); err != nil {
+			return err
+		}
+	}
+	_, _ = hasher.Write([]byte{0})
+	return nil
+}
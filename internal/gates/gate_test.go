@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -311,6 +312,108 @@ func TestRunPersistsEvidenceAndAttemptsIncrement(t *testing.T) {
 	}
 }
 
+func TestRunCachesUnchangedGateWithinMission(t *testing.T) {
+	t.Parallel()
+
+	workdir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workdir, "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatalf("write worktree file: %v", err)
+	}
+	counter := filepath.Join(t.TempDir(), "runs.log")
+	evidence := &fakeEvidenceStore{}
+	runner, err := NewShellRunner(evidence, nil, nil, RunnerConfig{
+		ProjectCommands: map[string][]string{
+			GateTypeVerifyREFACTOR: {"echo run >> " + counter},
+		},
+		CacheResults: true,
+	})
+	if err != nil {
+		t.Fatalf("new shell runner: %v", err)
+	}
+
+	runCount := func() int {
+		data, readErr := os.ReadFile(counter)
+		if readErr != nil {
+			t.Fatalf("read run counter: %v", readErr)
+		}
+		return strings.Count(string(data), "run")
+	}
+	run := func() *GateResult {
+		result, runErr := runner.Run(context.Background(), GateTypeVerifyREFACTOR, workdir, "mission-cache")
+		if runErr != nil {
+			t.Fatalf("run: %v", runErr)
+		}
+		return result
+	}
+
+	first := run()
+	second := run()
+	if got := runCount(); got != 1 {
+		t.Fatalf("gate executions = %d, want 1 for unchanged worktree", got)
+	}
+	if first.Cached || !second.Cached || second.Attempt != first.Attempt+1 || second.Classification != ClassificationAccept {
+		t.Fatalf("cached result = %+v, want first result %+v reused as the next attempt", second, first)
+	}
+	if len(evidence.records) != 2 || !evidence.records[1].Cached || evidence.records[1].Attempt != 2 {
+		t.Fatalf("evidence records = %+v, want the cache hit recorded as attempt 2", evidence.records)
+	}
+
+	if err := os.WriteFile(filepath.Join(workdir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o600); err != nil {
+		t.Fatalf("edit worktree file: %v", err)
+	}
+	run()
+	if got := runCount(); got != 2 {
+		t.Fatalf("gate executions after edit = %d, want 2", got)
+	}
+
+	runner.InvalidateCache("mission-cache")
+	run()
+	if got := runCount(); got != 3 {
+		t.Fatalf("gate executions after invalidation = %d, want 3", got)
+	}
+}
+
+func TestRunCacheIgnoresGateOutputs(t *testing.T) {
+	t.Parallel()
+
+	workdir := t.TempDir()
+	for _, args := range [][]string{{"init", "-q"}, {"config", "user.email", "sc3@example.com"}, {"config", "user.name", "sc3"}} {
+		if out, err := exec.Command("git", append([]string{"-C", workdir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(workdir, ".gitignore"), []byte("coverage.out\n"), 0o600); err != nil {
+		t.Fatalf("write gitignore: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(workdir, "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatalf("write worktree file: %v", err)
+	}
+	counter := filepath.Join(t.TempDir(), "runs.log")
+	// The gate appends to an ignored coverage file and rewrites an unignored report.
+	runner, err := NewShellRunner(&fakeEvidenceStore{}, nil, nil, RunnerConfig{
+		ProjectCommands: map[string][]string{
+			GateTypeVerifyIMPLEMENT: {"echo run >> " + counter + " && date +%s%N >> coverage.out && echo ok > report.txt"},
+		},
+		CacheResults: true,
+	})
+	if err != nil {
+		t.Fatalf("new shell runner: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := runner.Run(context.Background(), GateTypeVerifyIMPLEMENT, workdir, "mission-outputs"); err != nil {
+			t.Fatalf("run %d: %v", i+1, err)
+		}
+	}
+	data, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatalf("read run counter: %v", err)
+	}
+	if got := strings.Count(string(data), "run"); got != 1 {
+		t.Fatalf("gate executions = %d, want 1 when only gate outputs changed", got)
+	}
+}
+
 func TestRunUsesMissionCommandsAndVariableSubstitution(t *testing.T) {
 	t.Parallel()
 
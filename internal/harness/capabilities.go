@@ -0,0 +1,35 @@
+package harness
+
+import "strings"
+
+// Capability describes optional features a harness backend can provide.
+type Capability struct {
+	// Streaming indicates incremental session output can be observed while the agent runs.
+	Streaming bool
+	// ToolUse indicates the agent can invoke shell and file tools inside its workdir.
+	ToolUse bool
+	// ReasoningCapture indicates the agent's reasoning trace can be captured for reviewers.
+	ReasoningCapture bool
+}
+
+// Capabilities returns the deterministic feature descriptor for a harness name.
+//
+// Unknown harnesses report no optional capabilities so callers never request
+// features the backend cannot honor.
+func Capabilities(name string) Capability {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "claude":
+		return Capability{
+			Streaming:        true,
+			ToolUse:          true,
+			ReasoningCapture: true,
+		}
+	case "codex":
+		return Capability{
+			Streaming: true,
+			ToolUse:   true,
+		}
+	default:
+		return Capability{}
+	}
+}
@@ -0,0 +1,27 @@
+package harness
+
+import "testing"
+
+func TestCapabilitiesReportsKnownHarnessFeatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want Capability
+	}{
+		{name: "claude", want: Capability{Streaming: true, ToolUse: true, ReasoningCapture: true}},
+		{name: " Codex ", want: Capability{Streaming: true, ToolUse: true}},
+		{name: "unknown", want: Capability{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Capabilities(tt.name); got != tt.want {
+				t.Fatalf("Capabilities(%q) = %+v, want %+v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
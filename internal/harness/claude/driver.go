@@ -119,13 +119,18 @@ func (d *Driver) SpawnSession(
 		maxTurns = 1
 	}
 
-	sessionName := fmt.Sprintf("sc3-%s-%s", roleSlug, extractMissionID(prompt))
-	command := buildClaudeCommand(prompt, model, maxTurns)
+	sessionTail := extractMissionID(prompt)
+	if named := normalizeSlug(opts.SessionName); named != "" {
+		sessionTail = named
+	}
+	sessionName := fmt.Sprintf("sc3-%s-%s", roleSlug, sessionTail)
+	command := buildClaudeCommand(prompt, model, maxTurns, opts.Sandboxed)
 
 	ctx, cancel := d.spawnContext(opts.Timeout)
 	defer cancel()
-	if _, err := d.runner.Run(ctx, "tmux", "new-session", "-d", "-s", sessionName, "-c", workdir, command); err != nil {
-		return nil, fmt.Errorf("create claude tmux session %s: %w", sessionName, err)
+	args := append([]string{"new-session", "-d", "-s", sessionName, "-c", workdir}, opts.TmuxEnvArgs()...)
+	if _, err := d.runner.Run(ctx, "tmux", append(args, command)...); err != nil {
+		return nil, fmt.Errorf("create claude tmux session %s: %w", sessionName, harness.ClassifyTmuxError(err))
 	}
 
 	pid := 0
@@ -261,10 +266,19 @@ func (d *Driver) lookupSessionOpts(session *harness.Session) (harness.SessionOpt
 	return opts, ok
 }
 
-func buildClaudeCommand(prompt string, model string, maxTurns int) string {
+// sandboxDisallowedTools are the Claude tools that reach the network; sandboxed sessions
+// run without them.
+const sandboxDisallowedTools = "WebFetch,WebSearch"
+
+func buildClaudeCommand(prompt string, model string, maxTurns int, sandboxed bool) string {
+	restrictions := ""
+	if sandboxed {
+		restrictions = " --disallowedTools " + sandboxDisallowedTools
+	}
 	return fmt.Sprintf(
-		"claude -p --model %s --verbose --max-turns %d %s",
+		"claude -p --model %s%s --verbose --max-turns %d %s",
 		model,
+		restrictions,
 		maxTurns,
 		shellQuote(prompt),
 	)
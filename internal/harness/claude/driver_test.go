@@ -55,6 +55,57 @@ func TestSpawnSessionConstructsClaudeCLIFlags(t *testing.T) {
 	}
 }
 
+func TestSpawnSessionUsesExplicitSessionName(t *testing.T) {
+	runner := &fakeRunner{}
+	driver, err := NewWithRunner(runner, DriverConfig{})
+	if err != nil {
+		t.Fatalf("new driver: %v", err)
+	}
+	driver.now = fixedNow
+
+	session, err := driver.SpawnSession(
+		"ensign",
+		"Work mission MISSION-42 immediately",
+		"/tmp/worktree",
+		harness.SessionOpts{Model: "opus", SessionName: "MISSION-42-attempt-2"},
+	)
+	if err != nil {
+		t.Fatalf("spawn session: %v", err)
+	}
+	if session.TmuxSession != "sc3-ensign-mission-42-attempt-2" {
+		t.Fatalf("tmux session = %q, want sc3-ensign-mission-42-attempt-2", session.TmuxSession)
+	}
+}
+
+func TestSpawnSessionAppliesSessionEnv(t *testing.T) {
+	runner := &fakeRunner{}
+	driver, err := NewWithRunner(runner, DriverConfig{})
+	if err != nil {
+		t.Fatalf("new driver: %v", err)
+	}
+	driver.now = fixedNow
+
+	if _, err := driver.SpawnSession(
+		"ensign",
+		"Work mission MISSION-42 immediately",
+		"/tmp/worktree",
+		harness.SessionOpts{Model: "opus", Env: map[string]string{"SC3_SANDBOXED": "1"}, Sandboxed: true},
+	); err != nil {
+		t.Fatalf("spawn session: %v", err)
+	}
+
+	call := runner.findCall(t, "tmux", "new-session")
+	if !containsInOrder(call.args, []string{"-c", "/tmp/worktree", "-e", "SC3_SANDBOXED=1"}) {
+		t.Fatalf("new-session args = %v, want sandbox env flag", call.args)
+	}
+	if !strings.HasPrefix(call.args[len(call.args)-1], "claude -p") {
+		t.Fatalf("last new-session arg = %q, want claude command", call.args[len(call.args)-1])
+	}
+	if !strings.Contains(call.args[len(call.args)-1], "--disallowedTools WebFetch,WebSearch") {
+		t.Fatalf("claude command = %q, want network tools disallowed for sandboxed session", call.args[len(call.args)-1])
+	}
+}
+
 func TestSpawnSessionUsesRoleModelFallback(t *testing.T) {
 	runner := &fakeRunner{
 		outputs: map[string][]byte{
@@ -135,13 +135,23 @@ func (d *Driver) SpawnSession(
 		model = defaultModel
 	}
 
-	sessionName := fmt.Sprintf("sc3-%s-%s", roleSlug, extractMissionID(prompt))
-	command := buildCodexCommand(prompt, model, d.sandboxMode, d.approvalPolicy)
+	sessionTail := extractMissionID(prompt)
+	if named := normalizeSlug(opts.SessionName); named != "" {
+		sessionTail = named
+	}
+	sessionName := fmt.Sprintf("sc3-%s-%s", roleSlug, sessionTail)
+	sandboxMode := d.sandboxMode
+	if opts.Sandboxed && sandboxMode == "danger-full-access" {
+		// workspace-write confines writes to workdir and disables network access.
+		sandboxMode = defaultSandboxMode
+	}
+	command := buildCodexCommand(prompt, model, sandboxMode, d.approvalPolicy)
 
 	ctx, cancel := spawnContext(opts.Timeout)
 	defer cancel()
-	if _, err := d.runner.Run(ctx, "tmux", "new-session", "-d", "-s", sessionName, "-c", workdir, command); err != nil {
-		return nil, fmt.Errorf("create codex tmux session %s: %w", sessionName, err)
+	args := append([]string{"new-session", "-d", "-s", sessionName, "-c", workdir}, opts.TmuxEnvArgs()...)
+	if _, err := d.runner.Run(ctx, "tmux", append(args, command)...); err != nil {
+		return nil, fmt.Errorf("create codex tmux session %s: %w", sessionName, harness.ClassifyTmuxError(err))
 	}
 
 	session := &harness.Session{
@@ -48,6 +48,38 @@ func TestSpawnSessionConstructsCodexCLIFlags(t *testing.T) {
 	}
 }
 
+func TestSpawnSessionConfinesSandboxedSessionsToWorkspace(t *testing.T) {
+	runner := &fakeRunner{}
+	driver, err := NewWithRunner(runner, DriverConfig{SandboxMode: "danger-full-access"})
+	if err != nil {
+		t.Fatalf("new driver: %v", err)
+	}
+	driver.now = fixedNow
+
+	if _, err := driver.SpawnSession("ensign", "Rotate keys for MISSION-7", "/tmp/worktree", harness.SessionOpts{Sandboxed: true}); err != nil {
+		t.Fatalf("spawn sandboxed session: %v", err)
+	}
+	if _, err := driver.SpawnSession("ensign", "Write docs for MISSION-8", "/tmp/worktree", harness.SessionOpts{}); err != nil {
+		t.Fatalf("spawn regular session: %v", err)
+	}
+
+	var commands []string
+	for _, call := range runner.calls {
+		if call.name == "tmux" && len(call.args) > 0 && call.args[0] == "new-session" {
+			commands = append(commands, call.args[len(call.args)-1])
+		}
+	}
+	if len(commands) != 2 {
+		t.Fatalf("new-session calls = %d, want 2", len(commands))
+	}
+	if !strings.Contains(commands[0], "codex --sandbox workspace-write") {
+		t.Fatalf("sandboxed codex command = %q, want workspace-write sandbox", commands[0])
+	}
+	if !strings.Contains(commands[1], "codex --sandbox danger-full-access") {
+		t.Fatalf("regular codex command = %q, want configured sandbox mode", commands[1])
+	}
+}
+
 func TestSpawnSessionUsesRoleModelFallback(t *testing.T) {
 	runner := &fakeRunner{}
 	driver, err := NewWithRunner(runner, DriverConfig{
@@ -0,0 +1,53 @@
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEchoDriverUsesDeterministicSessionIDsAndWritesDemoToken(t *testing.T) {
+	t.Parallel()
+
+	workdir := t.TempDir()
+	driver := NewEcho()
+	prompt := "Mission Context\n- mission_id: m-7\n- title: Add login\n- classification: RED_ALERT\n"
+
+	first, err := driver.SpawnSession("ensign", prompt, workdir, SessionOpts{})
+	if err != nil {
+		t.Fatalf("spawn implementer: %v", err)
+	}
+	second, err := driver.SpawnSession("ensign", prompt, workdir, SessionOpts{})
+	if err != nil {
+		t.Fatalf("spawn second implementer: %v", err)
+	}
+	reviewer, err := driver.SpawnSession("reviewer", prompt, workdir, SessionOpts{})
+	if err != nil {
+		t.Fatalf("spawn reviewer: %v", err)
+	}
+	if first.ID != "echo-ensign-1" || second.ID != "echo-ensign-2" || reviewer.ID != "echo-reviewer-1" {
+		t.Fatalf("session ids = %q, %q, %q", first.ID, second.ID, reviewer.ID)
+	}
+
+	token, err := os.ReadFile(filepath.Join(workdir, "demo", "MISSION-m-7.md"))
+	if err != nil {
+		t.Fatalf("read demo token: %v", err)
+	}
+	for _, want := range []string{`mission_id: "m-7"`, `title: "Add login"`, `classification: "RED_ALERT"`, `agent_id: "echo-ensign-2"`, "### tests"} {
+		if !strings.Contains(string(token), want) {
+			t.Fatalf("demo token missing %q:\n%s", want, token)
+		}
+	}
+
+	output, err := driver.SendMessage(reviewer, "")
+	if err != nil {
+		t.Fatalf("send reviewer message: %v", err)
+	}
+	if output != EchoApprovedVerdict {
+		t.Fatalf("reviewer output = %q, want %q", output, EchoApprovedVerdict)
+	}
+	if output, err := driver.SendMessage(first, ""); err != nil || output != "" {
+		t.Fatalf("implementer output = %q, %v; want empty", output, err)
+	}
+}
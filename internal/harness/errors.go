@@ -0,0 +1,35 @@
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTransient marks harness failures that may succeed when retried unchanged, for example a
+// tmux session name still held by a session that is exiting. Callers test for it with
+// errors.Is.
+var ErrTransient = errors.New("transient harness failure")
+
+// transientTmuxMarkers are tmux error fragments that indicate a retriable failure.
+var transientTmuxMarkers = []string{
+	"duplicate session",
+	"resource temporarily unavailable",
+	"server exited unexpectedly",
+	"lost server",
+}
+
+// ClassifyTmuxError wraps err with ErrTransient when it matches a known transient tmux
+// failure and returns it unchanged otherwise.
+func ClassifyTmuxError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := strings.ToLower(err.Error())
+	for _, marker := range transientTmuxMarkers {
+		if strings.Contains(message, marker) {
+			return fmt.Errorf("%w: %w", ErrTransient, err)
+		}
+	}
+	return err
+}
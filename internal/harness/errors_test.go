@@ -0,0 +1,23 @@
+package harness
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyTmuxErrorMarksOnlyTransientFailures(t *testing.T) {
+	t.Parallel()
+
+	duplicate := errors.New("run tmux new-session: exit status 1 (duplicate session: sc3-ensign-m1)")
+	if got := ClassifyTmuxError(duplicate); !errors.Is(got, ErrTransient) || !errors.Is(got, duplicate) {
+		t.Fatalf("classified duplicate session error = %v, want transient wrapping the original", got)
+	}
+
+	missing := errors.New("run tmux new-session: exec: \"tmux\": executable file not found in $PATH")
+	if got := ClassifyTmuxError(missing); errors.Is(got, ErrTransient) || got != missing {
+		t.Fatalf("classified missing tmux error = %v, want it unchanged", got)
+	}
+	if ClassifyTmuxError(nil) != nil {
+		t.Fatal("classified nil error should stay nil")
+	}
+}
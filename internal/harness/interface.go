@@ -1,6 +1,10 @@
 package harness
 
-import "time"
+import (
+	"sort"
+	"strings"
+	"time"
+)
 
 // SessionStatus represents the lifecycle state of one harness-backed session.
 type SessionStatus string
@@ -20,6 +24,31 @@ type SessionOpts struct {
 	MaxTurns int
 	Timeout  time.Duration
 	OnOutput func(chunk string)
+	// SessionName overrides the prompt-derived tmux session suffix when set.
+	SessionName string
+	// Env sets additional environment variables for the session process.
+	Env map[string]string
+	// Sandboxed asks the driver to run the session under its most restrictive isolation:
+	// writes confined to workdir and no network tools.
+	Sandboxed bool
+}
+
+// TmuxEnvArgs returns tmux new-session -e flags for Env, sorted by key for deterministic commands.
+func (o SessionOpts) TmuxEnvArgs() []string {
+	keys := make([]string, 0, len(o.Env))
+	for key := range o.Env {
+		if strings.TrimSpace(key) == "" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		args = append(args, "-e", key+"="+o.Env[key])
+	}
+	return args
 }
 
 // SessionResult captures structured process output from one harness interaction.
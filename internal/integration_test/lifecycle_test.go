@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -327,7 +328,7 @@ func TestIntegrationMultiWaveExecutionWithReviewCheckpoint(t *testing.T) {
 		},
 	}
 	eventsPublisher := &integrationCommanderEventPublisher{}
-	approval := admiral.NewApprovalGate(3)
+	approval := admiral.NewApprovalGate(admiral.ApprovalGateBufferForWaves(2))
 	approvalDone := startApprovalResponder(approval, []admiral.ApprovalResponse{
 		{Decision: admiral.ApprovalDecisionApproved},
 		{Decision: admiral.ApprovalDecisionFeedback, FeedbackText: "carry wave checkpoint feedback into next mission"},
@@ -366,33 +367,51 @@ func TestIntegrationDoctorStuckDetection(t *testing.T) {
 	t.Parallel()
 
 	now := time.Date(2026, 2, 11, 4, 0, 0, 0, time.UTC)
-	store := &integrationDoctorStore{
-		snapshot: doctor.Snapshot{
-			Missions: []doctor.Mission{{ID: "mission-stuck", State: "in_progress", AgentID: "agent-1"}},
-			Agents: []doctor.Agent{{
-				ID:            "agent-1",
-				State:         "running",
-				SessionID:     "session-missing",
-				LastHeartbeat: now.Add(-10 * time.Minute),
-			}},
-		},
+	lastHeartbeat := now.Add(-10 * time.Minute)
+	const stuckTimeout = time.Minute
+
+	run := func(clock time.Time) (doctor.HealthReport, *integrationDoctorStore, *integrationDoctorSessions, *integrationEventBus) {
+		store := &integrationDoctorStore{
+			snapshot: doctor.Snapshot{
+				Missions: []doctor.Mission{{ID: "mission-stuck", State: "in_progress", AgentID: "agent-1"}},
+				Agents: []doctor.Agent{{
+					ID:            "agent-1",
+					State:         "running",
+					SessionID:     "session-missing",
+					LastHeartbeat: lastHeartbeat,
+				}},
+			},
+		}
+		sessions := &integrationDoctorSessions{active: map[string]struct{}{"zombie-session": {}}}
+		bus := &integrationEventBus{}
+
+		manager, err := doctor.NewManager(store, sessions, bus, doctor.Config{
+			HeartbeatInterval: time.Second,
+			StuckTimeout:      stuckTimeout,
+			Now:               func() time.Time { return clock },
+		})
+		require.NoError(t, err)
+
+		report, err := manager.RunOnce(context.Background())
+		require.NoError(t, err)
+		return report, store, sessions, bus
 	}
-	sessions := &integrationDoctorSessions{active: map[string]struct{}{"zombie-session": {}}}
-	bus := &integrationEventBus{}
 
-	manager, err := doctor.NewManager(store, sessions, bus, doctor.Config{
-		HeartbeatInterval: time.Second,
-		StuckTimeout:      time.Minute,
-	})
-	require.NoError(t, err)
-	managerNowOverride(manager, now)
+	// A heartbeat exactly StuckTimeout old is still healthy; staleness must exceed the timeout.
+	report, store, _, _ := run(lastHeartbeat.Add(stuckTimeout))
+	assert.Equal(t, 0, report.StuckAgents)
+	assert.Empty(t, store.stuckAgents)
+	assert.Equal(t, lastHeartbeat.Add(stuckTimeout), report.DoctorHeartbeat)
 
-	report, err := manager.RunOnce(context.Background())
-	require.NoError(t, err)
+	report, store, _, _ = run(lastHeartbeat.Add(stuckTimeout + time.Nanosecond))
+	assert.Equal(t, 1, report.StuckAgents)
+	assert.Equal(t, []string{"agent-1"}, store.stuckAgents)
 
+	report, store, sessions, bus := run(now)
 	assert.Equal(t, 1, report.StuckAgents)
 	assert.Equal(t, 1, report.OrphanedMissions)
 	assert.Equal(t, 1, report.ZombieSessions)
+	assert.Equal(t, now, report.DoctorHeartbeat)
 	assert.Equal(t, []string{"agent-1"}, store.stuckAgents)
 	assert.Equal(t, []string{"mission-stuck"}, store.backlogMissions)
 	assert.Equal(t, []string{"zombie-session"}, sessions.cleaned)
@@ -784,6 +803,13 @@ func (s *integrationDoctorStore) SetAgentStuck(_ context.Context, agentID string
 	return nil
 }
 
+func (s *integrationDoctorStore) ClearAgentStuck(_ context.Context, agentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stuckAgents = slices.DeleteFunc(s.stuckAgents, func(id string) bool { return id == agentID })
+	return nil
+}
+
 type integrationDoctorSessions struct {
 	active map[string]struct{}
 
@@ -908,12 +934,6 @@ func waitResponder(done <-chan error) error {
 	}
 }
 
-func managerNowOverride(manager *doctor.Manager, now time.Time) {
-	// The package does not export clock injection directly; this helper intentionally uses runtime behavior only.
-	_ = manager
-	_ = now
-}
-
 func recoveryNowOverride(manager *recovery.Manager, now time.Time) {
 	// The package does not export clock injection directly; this helper intentionally uses runtime behavior only.
 	_ = manager
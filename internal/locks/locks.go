@@ -159,7 +159,7 @@ func findConflicts(existing []Lock, requested []string) []Lock {
 func lockOverlaps(lock Lock, requested []string) bool {
 	for _, existingPattern := range lock.Patterns {
 		for _, requestedPattern := range requested {
-			if patternsOverlap(existingPattern, requestedPattern) {
+			if PatternsOverlap(existingPattern, requestedPattern) {
 				return true
 			}
 		}
@@ -167,7 +167,8 @@ func lockOverlaps(lock Lock, requested []string) bool {
 	return false
 }
 
-func patternsOverlap(a, b string) bool {
+// PatternsOverlap reports whether two surface-area patterns can match the same path.
+func PatternsOverlap(a, b string) bool {
 	a = filepath.ToSlash(strings.TrimSpace(a))
 	b = filepath.ToSlash(strings.TrimSpace(b))
 	if a == "" || b == "" {
@@ -31,6 +31,7 @@ type newOptions struct {
 	maxFiles        int
 	consoleToStderr bool
 	consoleWriter   io.Writer
+	exportWriter    io.Writer
 	level           log.Level
 }
 
@@ -83,6 +84,13 @@ func WithConsoleWriter(writer io.Writer) Option {
 	}
 }
 
+// WithExportWriter mirrors JSON log records to an additional export sink (for example OTLP).
+func WithExportWriter(writer io.Writer) Option {
+	return func(opts *newOptions) {
+		opts.exportWriter = writer
+	}
+}
+
 // WithLevel configures the runtime logger level.
 func WithLevel(level log.Level) Option {
 	return func(opts *newOptions) {
@@ -136,16 +144,23 @@ func New(ctx context.Context, options ...Option) (*RuntimeLogger, error) {
 		return nil, fmt.Errorf("open log file: %w", err)
 	}
 
-	sink := io.Writer(fileWriter)
+	sinks := []io.Writer{fileWriter}
 	if resolved.consoleToStderr {
 		consoleSink := resolved.consoleWriter
 		if consoleSink == nil {
 			consoleSink = os.Stderr
 		}
 		if consoleSink != nil {
-			sink = io.MultiWriter(fileWriter, consoleSink)
+			sinks = append(sinks, consoleSink)
 		}
 	}
+	if resolved.exportWriter != nil {
+		sinks = append(sinks, resolved.exportWriter)
+	}
+	sink := io.Writer(fileWriter)
+	if len(sinks) > 1 {
+		sink = io.MultiWriter(sinks...)
+	}
 
 	logger := log.NewWithOptions(sink, log.Options{
 		Level:           resolved.level,
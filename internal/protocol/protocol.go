@@ -38,6 +38,8 @@ const (
 	ReviewVerdictApproved = "APPROVED"
 	// ReviewVerdictNeedsFixes indicates reviewer requested implementer changes.
 	ReviewVerdictNeedsFixes = "NEEDS_FIXES"
+	// ReviewVerdictEscalate indicates reviewer needs an Admiral decision instead of another revision.
+	ReviewVerdictEscalate = "ESCALATE"
 )
 
 const (
@@ -326,6 +328,14 @@ func matchesClaim(event ProtocolEvent, acID string, claimType string) bool {
 	return strings.EqualFold(strings.TrimSpace(gotClaimType), strings.TrimSpace(claimType))
 }
 
+// ReviewVerdict returns the upper-cased verdict carried by a REVIEW_COMPLETE event.
+func ReviewVerdict(event ProtocolEvent) (string, bool) {
+	if event.Type != EventTypeReviewComplete {
+		return "", false
+	}
+	return extractReviewVerdict(event.Payload)
+}
+
 func extractReviewVerdict(payload json.RawMessage) (string, bool) {
 	var envelope map[string]any
 	if err := json.Unmarshal(payload, &envelope); err != nil {
@@ -352,7 +362,7 @@ func extractReviewVerdict(payload json.RawMessage) (string, bool) {
 
 func isSupportedReviewVerdict(value string) bool {
 	switch strings.TrimSpace(strings.ToUpper(value)) {
-	case ReviewVerdictApproved, ReviewVerdictNeedsFixes:
+	case ReviewVerdictApproved, ReviewVerdictNeedsFixes, ReviewVerdictEscalate:
 		return true
 	default:
 		return false
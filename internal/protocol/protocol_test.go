@@ -94,7 +94,7 @@ func TestPublishRejectsInvalidSchema(t *testing.T) {
 	_, err = service.Publish(context.Background(), ProtocolEvent{
 		Type:      EventTypeReviewComplete,
 		MissionID: "mission-1",
-		Payload:   json.RawMessage(`{"verdict":"ESCALATE"}`),
+		Payload:   json.RawMessage(`{"verdict":"MAYBE"}`),
 	})
 	if err == nil {
 		t.Fatal("expected unsupported review verdict error")
@@ -1,9 +1,13 @@
 package protocol
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
@@ -53,6 +57,136 @@ func (s *InMemoryStore) ListByMission(_ context.Context, missionID string) ([]Pr
 	return out, nil
 }
 
+const fileStoreExt = ".ndjson"
+
+// FileStore persists protocol events as newline-delimited JSON, one file per mission, so
+// recorded claims and review verdicts survive a process restart.
+type FileStore struct {
+	dir string
+
+	mu     sync.RWMutex
+	events map[string][]ProtocolEvent
+}
+
+// NewFileStore creates a file-backed protocol event store rooted at dir, creating dir when
+// missing and loading any events already recorded there.
+func NewFileStore(dir string) (*FileStore, error) {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil, fmt.Errorf("protocol store directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create protocol store directory %q: %w", dir, err)
+	}
+
+	store := &FileStore{
+		dir:    dir,
+		events: make(map[string][]ProtocolEvent),
+	}
+	if err := store.load(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// Append persists one protocol event by appending a single JSON line to the mission's file.
+func (s *FileStore) Append(_ context.Context, event ProtocolEvent) error {
+	missionID := strings.TrimSpace(event.MissionID)
+	if missionID == "" {
+		return fmt.Errorf("mission id must not be empty")
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal protocol event: %w", err)
+	}
+	line := append(body, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.missionPath(missionID)
+	// #nosec G304 -- path is derived from an escaped mission ID inside the store directory.
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open protocol event file %q: %w", path, err)
+	}
+	if _, err := file.Write(line); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("append protocol event to %q: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close protocol event file %q: %w", path, err)
+	}
+
+	s.events[missionID] = append(s.events[missionID], event)
+	return nil
+}
+
+// ListByMission returns protocol events for one mission in append order.
+func (s *FileStore) ListByMission(_ context.Context, missionID string) ([]ProtocolEvent, error) {
+	missionID = strings.TrimSpace(missionID)
+	if missionID == "" {
+		return nil, fmt.Errorf("mission id must not be empty")
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := s.events[missionID]
+	out := make([]ProtocolEvent, len(items))
+	copy(out, items)
+	return out, nil
+}
+
+func (s *FileStore) missionPath(missionID string) string {
+	return filepath.Join(s.dir, url.PathEscape(missionID)+fileStoreExt)
+}
+
+// load reads every mission file under the store directory. A trailing line without a
+// newline is a write torn by a crash; it is dropped and truncated so later appends stay
+// well-formed.
+func (s *FileStore) load() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("read protocol store directory %q: %w", s.dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fileStoreExt {
+			continue
+		}
+		path := filepath.Join(s.dir, entry.Name())
+		// #nosec G304 -- path comes from listing the store directory.
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read protocol event file %q: %w", path, err)
+		}
+
+		complete := content[:bytes.LastIndexByte(content, '\n')+1]
+		if len(complete) != len(content) {
+			if err := os.Truncate(path, int64(len(complete))); err != nil {
+				return fmt.Errorf("truncate torn protocol event in %q: %w", path, err)
+			}
+		}
+
+		for lineNo, line := range bytes.Split(complete, []byte("\n")) {
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			var event ProtocolEvent
+			if err := json.Unmarshal(line, &event); err != nil {
+				return fmt.Errorf("decode protocol event %s:%d: %w", path, lineNo+1, err)
+			}
+			missionID := strings.TrimSpace(event.MissionID)
+			if missionID == "" {
+				return fmt.Errorf("protocol event %s:%d has no mission id", path, lineNo+1)
+			}
+			s.events[missionID] = append(s.events[missionID], event)
+		}
+	}
+	return nil
+}
+
 type beadsClient interface {
 	AddComment(id, comment string) error
 	Show(id string) (*beads.Bead, error)
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -38,6 +42,134 @@ func TestInMemoryStoreAppendAndListByMission(t *testing.T) {
 	}
 }
 
+func TestFileStoreConcurrentAppendsAndReloadAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("new file store: %v", err)
+	}
+
+	const perMission = 25
+	missions := []string{"mission-a", "mission/b"}
+	var wg sync.WaitGroup
+	errs := make(chan error, perMission*len(missions))
+	for _, missionID := range missions {
+		for i := 0; i < perMission; i++ {
+			wg.Add(1)
+			go func(missionID string, i int) {
+				defer wg.Done()
+				errs <- store.Append(context.Background(), ProtocolEvent{
+					ProtocolVersion: ProtocolVersion,
+					Type:            EventTypeAgentClaim,
+					MissionID:       missionID,
+					Payload:         json.RawMessage(fmt.Sprintf(`{"claim_type":"RED_COMPLETE","seq":%d}`, i)),
+					Timestamp:       time.Date(2026, 2, 11, 12, 0, i, 0, time.UTC),
+				})
+			}(missionID, i)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+	if err := store.Append(context.Background(), ProtocolEvent{
+		ProtocolVersion: ProtocolVersion,
+		Type:            EventTypeReviewComplete,
+		MissionID:       "mission-a",
+		Payload:         json.RawMessage(`{"verdict":"APPROVED"}`),
+		Timestamp:       time.Date(2026, 2, 11, 13, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("append verdict: %v", err)
+	}
+
+	restarted, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("reload file store: %v", err)
+	}
+	for _, missionID := range missions {
+		before, err := store.ListByMission(context.Background(), missionID)
+		if err != nil {
+			t.Fatalf("list %s before restart: %v", missionID, err)
+		}
+		after, err := restarted.ListByMission(context.Background(), missionID)
+		if err != nil {
+			t.Fatalf("list %s after restart: %v", missionID, err)
+		}
+		if len(after) != len(before) {
+			t.Fatalf("%s events after restart = %d, want %d", missionID, len(after), len(before))
+		}
+		for i := range before {
+			if after[i].Type != before[i].Type || string(after[i].Payload) != string(before[i].Payload) {
+				t.Fatalf("%s event %d after restart = %+v, want %+v", missionID, i, after[i], before[i])
+			}
+		}
+	}
+
+	reloaded, err := restarted.ListByMission(context.Background(), "mission-a")
+	if err != nil {
+		t.Fatalf("list reloaded mission: %v", err)
+	}
+	if len(reloaded) != perMission+1 || reloaded[len(reloaded)-1].Type != EventTypeReviewComplete {
+		t.Fatalf("reloaded mission-a = %d events, want %d ending in %s", len(reloaded), perMission+1, EventTypeReviewComplete)
+	}
+}
+
+func TestFileStoreDropsTornTrailingWriteOnReload(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("new file store: %v", err)
+	}
+	event := ProtocolEvent{
+		ProtocolVersion: ProtocolVersion,
+		Type:            EventTypeStateTransition,
+		MissionID:       "mission-torn",
+		Payload:         json.RawMessage(`{"from":"red","to":"green"}`),
+		Timestamp:       time.Now().UTC(),
+	}
+	if err := store.Append(context.Background(), event); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	path := filepath.Join(dir, "mission-torn.ndjson")
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("open mission file: %v", err)
+	}
+	if _, err := file.WriteString(`{"protocol_version":"1.0","type":"REV`); err != nil {
+		t.Fatalf("write torn line: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("close mission file: %v", err)
+	}
+
+	restarted, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("reload file store: %v", err)
+	}
+	if err := restarted.Append(context.Background(), event); err != nil {
+		t.Fatalf("append after reload: %v", err)
+	}
+	again, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("second reload: %v", err)
+	}
+	events, err := again.ListByMission(context.Background(), "mission-torn")
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("event count = %d, want 2", len(events))
+	}
+}
+
 func TestBeadsStoreAppendAndListByMission(t *testing.T) {
 	t.Parallel()
 
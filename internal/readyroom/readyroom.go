@@ -1,24 +1,42 @@
 package readyroom
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"maps"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ship-commander/sc3/internal/admiral"
 	"github.com/ship-commander/sc3/internal/commander"
 	"github.com/ship-commander/sc3/internal/commission"
 	"github.com/ship-commander/sc3/internal/events"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
 	// DefaultMaxIterations bounds the planning loop when no override is provided.
 	DefaultMaxIterations = 5
+	// DefaultStallIterations is how many consecutive iterations without sign-off or coverage
+	// changes end planning early.
+	DefaultStallIterations = 2
+	// DefaultCoverageThreshold requires every use case to be covered before consensus.
+	DefaultCoverageThreshold = 1.0
 )
 
+// ErrQuestionTimeout reports that the Admiral did not answer a planning question within the
+// question timeout and the question had no default or skip to fall back on.
+var ErrQuestionTimeout = errors.New("admiral question timed out")
+
 // AgentRole identifies one planning specialist in the Ready Room.
 type AgentRole string
 
@@ -31,7 +49,9 @@ const (
 	RoleDesignOfficer AgentRole = "designOfficer"
 )
 
-var requiredRoles = []AgentRole{RoleCaptain, RoleCommander, RoleDesignOfficer}
+// defaultRequiredRoles are the planning roles whose sessions must all sign off on every
+// mission unless SetRequiredRoles overrides them.
+var defaultRequiredRoles = []AgentRole{RoleCaptain, RoleCommander, RoleDesignOfficer}
 
 // CoverageState describes use-case coverage status in planning output.
 type CoverageState string
@@ -49,19 +69,61 @@ const (
 //
 //nolint:revive // Required by issue contract and upstream planning schema.
 type ReadyRoomMessage struct {
-	From      string
-	To        string
-	Type      string
-	Domain    string
-	Content   string
-	Timestamp time.Time
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Type      string    `json:"type"`
+	Domain    string    `json:"domain,omitempty"`
+	Content   string    `json:"content"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
-// MissionSignoffs tracks deterministic three-way mission approval state.
+// MissionSignoffs tracks deterministic mission approval state per planning role. The
+// default roles have dedicated fields; any other configured role is tracked in Roles.
 type MissionSignoffs struct {
 	Captain       bool
 	Commander     bool
 	DesignOfficer bool
+	Roles         map[AgentRole]bool
+}
+
+// Signed reports whether role has signed off on the mission.
+func (s MissionSignoffs) Signed(role AgentRole) bool {
+	switch role {
+	case RoleCaptain:
+		return s.Captain
+	case RoleCommander:
+		return s.Commander
+	case RoleDesignOfficer:
+		return s.DesignOfficer
+	default:
+		return s.Roles[role]
+	}
+}
+
+// SignedBy reports whether every role in roles has signed off on the mission.
+func (s MissionSignoffs) SignedBy(roles []AgentRole) bool {
+	for _, role := range roles {
+		if !s.Signed(role) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *MissionSignoffs) set(role AgentRole, signed bool) {
+	switch role {
+	case RoleCaptain:
+		s.Captain = signed
+	case RoleCommander:
+		s.Commander = signed
+	case RoleDesignOfficer:
+		s.DesignOfficer = signed
+	default:
+		if s.Roles == nil {
+			s.Roles = make(map[AgentRole]bool)
+		}
+		s.Roles[role] = signed
+	}
 }
 
 // MissionPlan is one planned mission candidate produced by Ready Room sessions.
@@ -76,14 +138,26 @@ type MissionPlan struct {
 	ClassificationConfidence   string
 	ClassificationNeedsReview  bool
 	ClassificationReviewSource string
+	// Harness and Model are the mission's execution settings, inherited from
+	// commission defaults unless a contribution overrides them.
+	Harness string
+	Model   string
+	// WaveHint is the latest PRD wave hint among the mission's use cases; zero means none.
+	WaveHint int
+	// ACRefs are the acceptance criterion IDs the mission satisfies.
+	ACRefs []string
 }
 
 // MissionContribution captures a single session's mission-level output for one iteration.
 type MissionContribution struct {
-	MissionID              string
-	Title                  string
-	UseCaseIDs             []string
-	SignOff                bool
+	MissionID  string
+	Title      string
+	UseCaseIDs []string
+	// ACRefs lists acceptance criterion IDs the mission satisfies; they accumulate across contributions.
+	ACRefs  []string
+	SignOff bool
+	// WithdrawSignOff clears the contributing role's earlier signoff; it takes precedence over SignOff.
+	WithdrawSignOff        bool
 	UseCaseContext         string
 	FunctionalRequirements string
 	DesignRequirements     string
@@ -98,6 +172,8 @@ type SessionInput struct {
 	Iteration  int
 	Commission commission.Commission
 	Inbox      []ReadyRoomMessage
+	// Glossary is the commission's shared term definitions; each session receives its own copy.
+	Glossary map[string]string
 }
 
 // SessionOutput is what one session returns for a single planning iteration.
@@ -134,17 +210,25 @@ type MissionClassifier interface {
 type PlanResult struct {
 	Missions    []MissionPlan
 	Coverage    map[string]CoverageState
+	ACCoverage  map[string]CoverageState
 	Messages    []ReadyRoomMessage
 	QuestionLog []admiral.QuestionRecord
 	Iterations  int
 	Consensus   bool
+	// DroppedQuestions counts questions per role that exceeded the question budget.
+	DroppedQuestions map[AgentRole]int
+	// StalledAt is the iteration at which planning stopped because sign-offs and coverage
+	// stopped changing; zero when planning did not stall.
+	StalledAt int
 }
 
-// ReadyRoom coordinates planning across captain, commander, and design officer sessions.
+// ReadyRoom coordinates planning across captain, commander, and design officer sessions,
+// or the role set configured with SetRequiredRoles.
 type ReadyRoom struct {
 	factory       SessionFactory
 	commission    commission.Commission
 	maxIterations int
+	roles         []AgentRole
 	now           func() time.Time
 	classifier    MissionClassifier
 
@@ -154,6 +238,16 @@ type ReadyRoom struct {
 	missionPlan  map[string]*MissionPlan
 	eventBus     events.Bus
 	questionGate *admiral.QuestionGate
+
+	questionBudget   int
+	questionCounts   map[AgentRole]int
+	droppedQuestions map[AgentRole]int
+	questionTimeout  time.Duration
+
+	spawnConcurrency   int
+	concurrentSessions bool
+	stallIterations    int
+	coverageThreshold  float64
 }
 
 // New builds a ReadyRoom planning coordinator.
@@ -173,12 +267,16 @@ func New(factory SessionFactory, comm commission.Commission, maxIterations int)
 		commission:    comm,
 		maxIterations: maxIterations,
 		now:           time.Now,
-		sessions:      make(map[AgentRole]Session, len(requiredRoles)),
-		mailboxes:     make(map[AgentRole][]ReadyRoomMessage, len(requiredRoles)),
+		sessions:      make(map[AgentRole]Session, len(defaultRequiredRoles)),
+		mailboxes:     make(map[AgentRole][]ReadyRoomMessage, len(defaultRequiredRoles)),
 		messages:      make([]ReadyRoomMessage, 0),
 		missionPlan:   make(map[string]*MissionPlan),
 		eventBus:      events.New(),
 		questionGate:  admiral.NewQuestionGate(1),
+
+		spawnConcurrency:  1,
+		stallIterations:   DefaultStallIterations,
+		coverageThreshold: DefaultCoverageThreshold,
 	}, nil
 }
 
@@ -190,6 +288,15 @@ func (r *ReadyRoom) QuestionGate() *admiral.QuestionGate {
 	return r.questionGate
 }
 
+// PendingQuestions returns Admiral questions the planning loop is still waiting on, so
+// headers can show an authoritative pending count.
+func (r *ReadyRoom) PendingQuestions() []admiral.AdmiralQuestion {
+	if r == nil {
+		return nil
+	}
+	return r.questionGate.PendingQuestions()
+}
+
 // SetEventBus overrides the default event bus.
 func (r *ReadyRoom) SetEventBus(bus events.Bus) error {
 	if r == nil {
@@ -214,11 +321,125 @@ func (r *ReadyRoom) SetMissionClassifier(classifier MissionClassifier) error {
 	return nil
 }
 
+// SetQuestionBudget limits how many Admiral questions each role may ask per planning run.
+// Questions beyond the budget are logged as warnings instead of blocking planning.
+// A budget of zero disables the limit.
+func (r *ReadyRoom) SetQuestionBudget(perRole int) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	if perRole < 0 {
+		return fmt.Errorf("question budget must be non-negative, got %d", perRole)
+	}
+	r.questionBudget = perRole
+	return nil
+}
+
+// SetQuestionTimeout bounds how long each Admiral question may block planning. A question
+// that times out falls back to its default option or a skip when it allows one; otherwise
+// planning fails with ErrQuestionTimeout. Zero waits indefinitely.
+func (r *ReadyRoom) SetQuestionTimeout(timeout time.Duration) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	if timeout < 0 {
+		return fmt.Errorf("question timeout must be non-negative, got %s", timeout)
+	}
+	r.questionTimeout = timeout
+	return nil
+}
+
+// SetSpawnConcurrency bounds how many role sessions spawn in parallel. The default of 1
+// spawns sessions sequentially.
+func (r *ReadyRoom) SetSpawnConcurrency(limit int) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	if limit <= 0 {
+		return fmt.Errorf("spawn concurrency must be positive, got %d", limit)
+	}
+	r.spawnConcurrency = limit
+	return nil
+}
+
+// SetStallIterations ends planning without consensus once sign-offs and coverage have not
+// changed for this many consecutive iterations. Zero disables stall detection.
+func (r *ReadyRoom) SetStallIterations(iterations int) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	if iterations < 0 {
+		return fmt.Errorf("stall iterations must be non-negative, got %d", iterations)
+	}
+	r.stallIterations = iterations
+	return nil
+}
+
+// SetCoverageThreshold accepts a plan as consensus once this fraction of use cases is
+// covered and every mission is signed off, leaving the rest reported as known gaps in
+// PlanResult.Coverage. The threshold must be in (0, 1]; the default is 1.0.
+func (r *ReadyRoom) SetCoverageThreshold(threshold float64) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	if threshold <= 0 || threshold > 1 {
+		return fmt.Errorf("coverage threshold must be in (0, 1], got %v", threshold)
+	}
+	r.coverageThreshold = threshold
+	return nil
+}
+
+// SetConcurrentSessions runs every role session of an iteration in parallel when enabled.
+// Outputs are still applied in role order, but each session only sees messages routed
+// before the iteration began.
+func (r *ReadyRoom) SetConcurrentSessions(enabled bool) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	r.concurrentSessions = enabled
+	return nil
+}
+
+// SetRequiredRoles overrides the planning roles that spawn sessions and must all sign off
+// on every mission, for example dropping the design officer on a commission with no
+// design surface. Call it before Plan.
+func (r *ReadyRoom) SetRequiredRoles(roles []AgentRole) error {
+	if r == nil {
+		return errors.New("ready room is nil")
+	}
+	normalized := make([]AgentRole, 0, len(roles))
+	for _, role := range roles {
+		role = AgentRole(strings.TrimSpace(string(role)))
+		if role == "" {
+			return errors.New("required role must not be empty")
+		}
+		if slices.Contains(normalized, role) {
+			return fmt.Errorf("required role %q listed more than once", role)
+		}
+		normalized = append(normalized, role)
+	}
+	if len(normalized) == 0 {
+		return errors.New("at least one required role is needed")
+	}
+	r.roles = normalized
+	return nil
+}
+
+// requiredRoles returns the configured planning roles, falling back to the defaults.
+func (r *ReadyRoom) requiredRoles() []AgentRole {
+	if len(r.roles) == 0 {
+		return defaultRequiredRoles
+	}
+	return r.roles
+}
+
 // Plan executes the deterministic planning loop until consensus or max iterations.
 func (r *ReadyRoom) Plan(ctx context.Context) (result PlanResult, err error) {
 	if r == nil {
 		return PlanResult{}, errors.New("ready room is nil")
 	}
+	r.questionCounts = make(map[AgentRole]int, len(r.requiredRoles()))
+	r.droppedQuestions = make(map[AgentRole]int, len(r.requiredRoles()))
 
 	if err := r.spawnSessions(ctx); err != nil {
 		return PlanResult{}, err
@@ -235,66 +456,181 @@ func (r *ReadyRoom) Plan(ctx context.Context) (result PlanResult, err error) {
 		err = errors.Join(err, closeErr)
 	}()
 
+	var previousCoverage map[string]CoverageState
+	var previousProgress uint64
+	unchanged := 0
 	for iteration := 1; iteration <= r.maxIterations; iteration++ {
-		for _, role := range requiredRoles {
-			session, ok := r.sessions[role]
-			if !ok {
-				return PlanResult{}, fmt.Errorf("session for role %q not found", role)
-			}
-
-			input := SessionInput{
-				Iteration:  iteration,
-				Commission: r.commission,
-				Inbox:      append([]ReadyRoomMessage(nil), r.mailboxes[role]...),
-			}
-			r.mailboxes[role] = nil
-
-			output, err := session.Execute(ctx, input)
-			if err != nil {
-				return PlanResult{}, fmt.Errorf("execute session role=%s id=%s: %w", role, session.ID(), err)
-			}
-
-			if err := r.handleQuestions(ctx, role, output.Questions); err != nil {
-				return PlanResult{}, err
-			}
-			if err := r.mergeMissionContributions(ctx, role, output.Missions); err != nil {
-				return PlanResult{}, err
-			}
-			if err := r.routeMessages(role, output.Messages); err != nil {
-				return PlanResult{}, err
-			}
+		if err := r.runIteration(ctx, iteration); err != nil {
+			return PlanResult{}, err
 		}
 
 		consensus, coverage := r.ValidateConsensus()
+		r.reportCoverageRegressions(iteration, previousCoverage, coverage)
 		if consensus {
 			return r.buildResult(iteration, coverage, true), nil
 		}
+		previousCoverage = coverage
+
+		progress := r.progressHash(coverage)
+		if iteration > 1 && progress == previousProgress {
+			unchanged++
+		} else {
+			unchanged = 0
+		}
+		previousProgress = progress
+		if r.stallIterations > 0 && unchanged >= r.stallIterations {
+			result := r.buildResult(iteration, coverage, false)
+			result.StalledAt = iteration
+			return result, nil
+		}
 	}
 
 	_, coverage := r.ValidateConsensus()
 	return r.buildResult(r.maxIterations, coverage, false), nil
 }
 
-// ValidateConsensus deterministically checks signoff and use-case coverage completion.
+// progressHash fingerprints the planning state stall detection watches: each mission's
+// sign-offs from the required roles and use-case coverage.
+func (r *ReadyRoom) progressHash(coverage map[string]CoverageState) uint64 {
+	hash := fnv.New64a()
+	for _, missionID := range slices.Sorted(maps.Keys(r.missionPlan)) {
+		fmt.Fprintf(hash, "mission=%s", missionID)
+		for _, role := range r.requiredRoles() {
+			fmt.Fprintf(hash, " %s=%t", role, r.missionPlan[missionID].Signoffs.Signed(role))
+		}
+		hash.Write([]byte{'\n'})
+	}
+	for _, useCaseID := range slices.Sorted(maps.Keys(coverage)) {
+		fmt.Fprintf(hash, "coverage %s=%s\n", useCaseID, coverage[useCaseID])
+	}
+	return hash.Sum64()
+}
+
+// runIteration executes every role session once. Sequential mode applies each session's
+// output before the next role runs, so later roles see messages routed earlier in the
+// iteration. Concurrent mode snapshots every inbox first, runs the sessions in parallel,
+// and then applies outputs in role order.
+func (r *ReadyRoom) runIteration(ctx context.Context, iteration int) error {
+	roles := r.requiredRoles()
+	sessions := make([]Session, len(roles))
+	for i, role := range roles {
+		session, ok := r.sessions[role]
+		if !ok {
+			return fmt.Errorf("session for role %q not found", role)
+		}
+		sessions[i] = session
+	}
+
+	if !r.concurrentSessions {
+		for i, role := range roles {
+			output, err := r.executeSession(ctx, iteration, role, sessions[i])
+			if err != nil {
+				return err
+			}
+			if err := r.applySessionOutput(ctx, role, output); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	inputs := make([]SessionInput, len(roles))
+	for i, role := range roles {
+		inputs[i] = r.takeSessionInput(iteration, role)
+	}
+	outputs := make([]SessionOutput, len(roles))
+	errs := make([]error, len(roles))
+	var wg sync.WaitGroup
+	for i, role := range roles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := sessions[i].Execute(ctx, inputs[i])
+			if err != nil {
+				errs[i] = fmt.Errorf("execute session role=%s id=%s: %w", role, sessions[i].ID(), err)
+				return
+			}
+			outputs[i] = output
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	for i, role := range roles {
+		if err := r.applySessionOutput(ctx, role, outputs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ReadyRoom) executeSession(ctx context.Context, iteration int, role AgentRole, session Session) (SessionOutput, error) {
+	output, err := session.Execute(ctx, r.takeSessionInput(iteration, role))
+	if err != nil {
+		return SessionOutput{}, fmt.Errorf("execute session role=%s id=%s: %w", role, session.ID(), err)
+	}
+	return output, nil
+}
+
+// takeSessionInput builds the role's input and drains its mailbox.
+func (r *ReadyRoom) takeSessionInput(iteration int, role AgentRole) SessionInput {
+	input := SessionInput{
+		Iteration:  iteration,
+		Commission: r.commission,
+		Inbox:      append([]ReadyRoomMessage(nil), r.mailboxes[role]...),
+		Glossary:   maps.Clone(r.commission.Glossary),
+	}
+	r.mailboxes[role] = nil
+	return input
+}
+
+func (r *ReadyRoom) applySessionOutput(ctx context.Context, role AgentRole, output SessionOutput) error {
+	if err := r.handleQuestions(ctx, role, output.Questions); err != nil {
+		return err
+	}
+	if err := r.mergeMissionContributions(ctx, role, output.Missions); err != nil {
+		return err
+	}
+	return r.routeMessages(role, output.Messages)
+}
+
+// ValidateConsensus deterministically checks signoff and use-case coverage completion. Every
+// mission must be signed off by the required roles and the covered fraction of use cases
+// must meet the coverage threshold.
 func (r *ReadyRoom) ValidateConsensus() (bool, map[string]CoverageState) {
 	if r == nil {
 		return false, nil
 	}
 
 	for _, mission := range r.missionPlan {
-		if !mission.Signoffs.Captain || !mission.Signoffs.Commander || !mission.Signoffs.DesignOfficer {
+		if !mission.Signoffs.SignedBy(r.requiredRoles()) {
 			return false, r.BuildUseCaseCoverage()
 		}
 	}
 
 	coverage := r.BuildUseCaseCoverage()
+	if len(coverage) == 0 {
+		return true, coverage
+	}
+	covered := 0
 	for _, status := range coverage {
-		if status != CoverageCovered {
-			return false, coverage
+		if status == CoverageCovered {
+			covered++
 		}
 	}
 
-	return true, coverage
+	return float64(covered)/float64(len(coverage)) >= r.requiredCoverage(), coverage
+}
+
+// requiredCoverage returns the configured coverage threshold, falling back to the default.
+func (r *ReadyRoom) requiredCoverage() float64 {
+	if r.coverageThreshold <= 0 {
+		return DefaultCoverageThreshold
+	}
+	return r.coverageThreshold
 }
 
 // BuildUseCaseCoverage computes covered/partial/uncovered across commission use cases.
@@ -309,7 +645,7 @@ func (r *ReadyRoom) BuildUseCaseCoverage() map[string]CoverageState {
 			if _, ok := coverage[useCaseID]; !ok {
 				continue
 			}
-			if mission.Signoffs.Captain && mission.Signoffs.Commander && mission.Signoffs.DesignOfficer {
+			if mission.Signoffs.SignedBy(r.requiredRoles()) {
 				coverage[useCaseID] = CoverageCovered
 				continue
 			}
@@ -322,23 +658,119 @@ func (r *ReadyRoom) BuildUseCaseCoverage() map[string]CoverageState {
 	return coverage
 }
 
-func (r *ReadyRoom) spawnSessions(ctx context.Context) error {
-	for _, role := range requiredRoles {
-		if _, exists := r.sessions[role]; exists {
+// BuildACCoverage computes covered/partial/uncovered across commission acceptance criteria,
+// both commission-level and per use case, from the AC IDs missions reference.
+func (r *ReadyRoom) BuildACCoverage() map[string]CoverageState {
+	coverage := make(map[string]CoverageState, len(r.commission.AcceptanceCriteria))
+	for _, ac := range r.commission.AcceptanceCriteria {
+		coverage[ac.ID] = CoverageUncovered
+	}
+	for _, useCase := range r.commission.UseCases {
+		for _, ac := range useCase.AcceptanceCriteria {
+			coverage[ac.ID] = CoverageUncovered
+		}
+	}
+
+	for _, mission := range r.missionPlan {
+		for _, acID := range mission.ACRefs {
+			if _, ok := coverage[acID]; !ok {
+				continue
+			}
+			if mission.Signoffs.SignedBy(r.requiredRoles()) {
+				coverage[acID] = CoverageCovered
+				continue
+			}
+			if coverage[acID] != CoverageCovered {
+				coverage[acID] = CoveragePartial
+			}
+		}
+	}
+
+	return coverage
+}
+
+// coverageRank orders coverage states so regressions can be detected between iterations.
+var coverageRank = map[CoverageState]int{
+	CoverageUncovered: 0,
+	CoveragePartial:   1,
+	CoverageCovered:   2,
+}
+
+// reportCoverageRegressions publishes a warning when any use case lost coverage since the
+// previous iteration, typically because a mission signoff was withdrawn.
+func (r *ReadyRoom) reportCoverageRegressions(iteration int, previous, current map[string]CoverageState) {
+	if r.eventBus == nil || previous == nil {
+		return
+	}
+
+	regressions := make([]string, 0)
+	for _, useCase := range r.commission.UseCases {
+		before, ok := previous[useCase.ID]
+		if !ok {
 			continue
 		}
+		after := current[useCase.ID]
+		if coverageRank[after] < coverageRank[before] {
+			regressions = append(regressions, fmt.Sprintf("%s %s->%s", useCase.ID, before, after))
+		}
+	}
+	if len(regressions) == 0 {
+		return
+	}
 
-		session, err := r.factory.Spawn(ctx, SpawnRequest{
-			Role:       role,
-			Commission: r.commission,
-		})
-		if err != nil {
-			return fmt.Errorf("spawn session %s: %w", role, err)
+	r.eventBus.Publish(events.Event{
+		Type:       events.EventTypeSystemAlert,
+		EntityType: "planning_coverage",
+		EntityID:   strings.TrimSpace(r.commission.ID),
+		Payload: map[string]string{
+			"iteration":   strconv.Itoa(iteration),
+			"regressions": strings.Join(regressions, ", "),
+			"reason":      "use case coverage regressed between planning iterations",
+		},
+		Severity: events.SeverityWarn,
+	})
+}
+
+// spawnSessions spawns every missing role session with at most spawnConcurrency in flight.
+// Failures are joined in role order so each error names its role.
+func (r *ReadyRoom) spawnSessions(ctx context.Context) error {
+	pending := make([]AgentRole, 0, len(r.requiredRoles()))
+	for _, role := range r.requiredRoles() {
+		if _, exists := r.sessions[role]; !exists {
+			pending = append(pending, role)
 		}
-		r.sessions[role] = session
 	}
 
-	return nil
+	sessions := make([]Session, len(pending))
+	errs := make([]error, len(pending))
+	slots := make(chan struct{}, max(1, r.spawnConcurrency))
+	var wg sync.WaitGroup
+	for i, role := range pending {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			session, err := r.factory.Spawn(ctx, SpawnRequest{
+				Role:       role,
+				Commission: r.commission,
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("spawn session %s: %w", role, err)
+				return
+			}
+			sessions[i] = session
+		}()
+	}
+	wg.Wait()
+
+	for i, role := range pending {
+		if sessions[i] != nil {
+			r.sessions[role] = sessions[i]
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func (r *ReadyRoom) closeSessions(ctx context.Context) error {
@@ -366,6 +798,8 @@ func (r *ReadyRoom) mergeMissionContributions(
 			mission = &MissionPlan{
 				ID:         missionID,
 				UseCaseIDs: make([]string, 0),
+				Harness:    strings.TrimSpace(r.commission.DefaultHarness),
+				Model:      strings.TrimSpace(r.commission.DefaultModel),
 			}
 			r.missionPlan[missionID] = mission
 		}
@@ -375,6 +809,12 @@ func (r *ReadyRoom) mergeMissionContributions(
 		if mission.Title == "" {
 			mission.Title = mission.ID
 		}
+		if harness := strings.TrimSpace(contribution.Harness); harness != "" {
+			mission.Harness = harness
+		}
+		if model := strings.TrimSpace(contribution.Model); model != "" {
+			mission.Model = model
+		}
 
 		for _, useCaseID := range contribution.UseCaseIDs {
 			useCaseID = strings.TrimSpace(useCaseID)
@@ -383,23 +823,23 @@ func (r *ReadyRoom) mergeMissionContributions(
 			}
 			mission.UseCaseIDs = append(mission.UseCaseIDs, useCaseID)
 		}
+		for _, acID := range contribution.ACRefs {
+			acID = strings.TrimSpace(acID)
+			if acID == "" || slices.Contains(mission.ACRefs, acID) {
+				continue
+			}
+			mission.ACRefs = append(mission.ACRefs, acID)
+		}
 
 		if err := r.applyCommanderClassification(ctx, role, mission, contribution); err != nil {
 			return err
 		}
 
-		if !contribution.SignOff {
+		if !contribution.SignOff && !contribution.WithdrawSignOff {
 			continue
 		}
 
-		switch role {
-		case RoleCaptain:
-			mission.Signoffs.Captain = true
-		case RoleCommander:
-			mission.Signoffs.Commander = true
-		case RoleDesignOfficer:
-			mission.Signoffs.DesignOfficer = true
-		}
+		mission.Signoffs.set(role, !contribution.WithdrawSignOff)
 	}
 
 	return nil
@@ -424,8 +864,8 @@ func (r *ReadyRoom) applyCommanderClassification(
 		CommissionTitle:        strings.TrimSpace(r.commission.Title),
 		Domain:                 strings.TrimSpace(contribution.Domain),
 		Dependencies:           append([]string(nil), contribution.Dependencies...),
-		Harness:                strings.TrimSpace(contribution.Harness),
-		Model:                  strings.TrimSpace(contribution.Model),
+		Harness:                mission.Harness,
+		Model:                  mission.Model,
 	}
 
 	result, err := r.classifier.ClassifyMission(ctx, input)
@@ -529,7 +969,7 @@ func (r *ReadyRoom) routeMessages(from AgentRole, messages []ReadyRoomMessage) e
 
 		switch normalized.To {
 		case "all", "broadcast":
-			for _, role := range requiredRoles {
+			for _, role := range r.requiredRoles() {
 				if role == from {
 					continue
 				}
@@ -537,7 +977,7 @@ func (r *ReadyRoom) routeMessages(from AgentRole, messages []ReadyRoomMessage) e
 			}
 		default:
 			role := AgentRole(normalized.To)
-			if !slices.Contains(requiredRoles, role) {
+			if !slices.Contains(r.requiredRoles(), role) {
 				return fmt.Errorf("route message from=%s: unknown recipient %q", from, normalized.To)
 			}
 			r.mailboxes[role] = append(r.mailboxes[role], normalized)
@@ -560,6 +1000,13 @@ func (r *ReadyRoom) handleQuestions(
 	}
 
 	for _, question := range questions {
+		if r.questionBudget > 0 && r.questionCounts[role] >= r.questionBudget {
+			r.dropQuestion(ctx, role, question)
+			continue
+		}
+		if r.questionCounts != nil {
+			r.questionCounts[role]++
+		}
 		if _, err := r.askQuestion(ctx, role, question); err != nil {
 			return err
 		}
@@ -568,6 +1015,37 @@ func (r *ReadyRoom) handleQuestions(
 	return nil
 }
 
+// dropQuestion records a question that exceeded the role's budget without blocking on the Admiral.
+func (r *ReadyRoom) dropQuestion(ctx context.Context, role AgentRole, question admiral.AdmiralQuestion) {
+	question.AskingAgent = string(role)
+	if r.droppedQuestions != nil {
+		r.droppedQuestions[role]++
+	}
+
+	if r.eventBus != nil {
+		r.eventBus.Publish(events.Event{
+			Type:       events.EventTypeSystemAlert,
+			EntityType: "planning_question",
+			EntityID:   strings.TrimSpace(question.QuestionID),
+			Payload: map[string]string{
+				"role":        string(role),
+				"question_id": strings.TrimSpace(question.QuestionID),
+				"reason":      fmt.Sprintf("question budget of %d exhausted", r.questionBudget),
+			},
+			Severity: events.SeverityWarn,
+		})
+	}
+
+	counter, err := otel.Meter("sc3/readyroom").Int64Counter(
+		"sc3.readyroom.questions_dropped",
+		metric.WithDescription("Planning questions dropped after a role exhausted its question budget."),
+	)
+	if err != nil {
+		return
+	}
+	counter.Add(ctx, 1, metric.WithAttributes(attribute.String("role", string(role))))
+}
+
 func (r *ReadyRoom) askQuestion(
 	ctx context.Context,
 	role AgentRole,
@@ -585,7 +1063,21 @@ func (r *ReadyRoom) askQuestion(
 		})
 	}
 
-	answer, err := r.questionGate.Ask(ctx, question)
+	// The timeout is per question so a slow answer never eats into later questions' budgets.
+	askCtx := ctx
+	if r.questionTimeout > 0 {
+		var cancel context.CancelFunc
+		askCtx, cancel = context.WithTimeout(ctx, r.questionTimeout)
+		defer cancel()
+	}
+	askedAt := r.now().UTC()
+	answer, err := r.questionGate.Ask(askCtx, question)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		answer, err = r.timeoutAnswer(ctx, role, question, askedAt)
+		if err != nil {
+			return admiral.AdmiralAnswer{}, err
+		}
+	}
 	if err != nil {
 		return admiral.AdmiralAnswer{}, fmt.Errorf(
 			"question gate ask role=%s question_id=%s: %w",
@@ -606,6 +1098,44 @@ func (r *ReadyRoom) askQuestion(
 	return answer, nil
 }
 
+// timeoutAnswer falls back to the question's default answer after the question timeout,
+// recording it as timed out, or returns ErrQuestionTimeout when there is no fallback.
+func (r *ReadyRoom) timeoutAnswer(
+	ctx context.Context,
+	role AgentRole,
+	question admiral.AdmiralQuestion,
+	askedAt time.Time,
+) (admiral.AdmiralAnswer, error) {
+	answer, ok := admiral.DefaultAnswer(question)
+	if !ok {
+		return admiral.AdmiralAnswer{}, fmt.Errorf(
+			"%w: role=%s question_id=%s after %s",
+			ErrQuestionTimeout,
+			role,
+			question.QuestionID,
+			r.questionTimeout,
+		)
+	}
+	if err := r.questionGate.RecordTimedOut(ctx, question, answer, askedAt); err != nil {
+		return admiral.AdmiralAnswer{}, fmt.Errorf("record timed-out question %s: %w", question.QuestionID, err)
+	}
+
+	if r.eventBus != nil {
+		r.eventBus.Publish(events.Event{
+			Type:       events.EventTypeSystemAlert,
+			EntityType: "planning_question",
+			EntityID:   strings.TrimSpace(question.QuestionID),
+			Payload: map[string]string{
+				"role":        string(role),
+				"question_id": strings.TrimSpace(question.QuestionID),
+				"reason":      fmt.Sprintf("no admiral answer within %s; applied default", r.questionTimeout),
+			},
+			Severity: events.SeverityWarn,
+		})
+	}
+	return answer, nil
+}
+
 func (r *ReadyRoom) routeAdmiralAnswer(
 	askingRole AgentRole,
 	question admiral.AdmiralQuestion,
@@ -632,7 +1162,7 @@ func (r *ReadyRoom) routeAdmiralAnswer(
 
 	broadcastMessage := message
 	broadcastMessage.To = "broadcast"
-	for _, role := range requiredRoles {
+	for _, role := range r.requiredRoles() {
 		if role == askingRole {
 			continue
 		}
@@ -658,6 +1188,18 @@ func formatAdmiralAnswer(answer admiral.AdmiralAnswer) string {
 	return strings.Join(parts, "\n")
 }
 
+// useCaseWaveHint returns the highest wave hint among the referenced commission use cases,
+// since a mission cannot run before any of the use cases it implements.
+func (r *ReadyRoom) useCaseWaveHint(useCaseIDs []string) int {
+	hint := 0
+	for _, useCase := range r.commission.UseCases {
+		if slices.Contains(useCaseIDs, useCase.ID) {
+			hint = max(hint, useCase.WaveHint)
+		}
+	}
+	return hint
+}
+
 func (r *ReadyRoom) buildResult(iterations int, coverage map[string]CoverageState, consensus bool) PlanResult {
 	missions := make([]MissionPlan, 0, len(r.missionPlan))
 	for _, mission := range r.missionPlan {
@@ -672,6 +1214,10 @@ func (r *ReadyRoom) buildResult(iterations int, coverage map[string]CoverageStat
 			ClassificationConfidence:   mission.ClassificationConfidence,
 			ClassificationNeedsReview:  mission.ClassificationNeedsReview,
 			ClassificationReviewSource: mission.ClassificationReviewSource,
+			Harness:                    mission.Harness,
+			Model:                      mission.Model,
+			WaveHint:                   r.useCaseWaveHint(mission.UseCaseIDs),
+			ACRefs:                     append([]string(nil), mission.ACRefs...),
 		})
 	}
 	slices.SortFunc(missions, func(a, b MissionPlan) int {
@@ -685,12 +1231,31 @@ func (r *ReadyRoom) buildResult(iterations int, coverage map[string]CoverageStat
 		questionLog = r.questionGate.History()
 	}
 
+	dropped := make(map[AgentRole]int, len(r.droppedQuestions))
+	for role, count := range r.droppedQuestions {
+		dropped[role] = count
+	}
+
 	return PlanResult{
-		Missions:    missions,
-		Coverage:    coverage,
-		Messages:    messages,
-		QuestionLog: questionLog,
-		Iterations:  iterations,
-		Consensus:   consensus,
+		Missions:         missions,
+		Coverage:         coverage,
+		ACCoverage:       r.BuildACCoverage(),
+		Messages:         messages,
+		QuestionLog:      questionLog,
+		Iterations:       iterations,
+		Consensus:        consensus,
+		DroppedQuestions: dropped,
+	}
+}
+
+// ExportMessages serializes the planning message history as JSONL, one routing envelope per line.
+func ExportMessages(result PlanResult) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for i, message := range result.Messages {
+		if err := encoder.Encode(message); err != nil {
+			return nil, fmt.Errorf("export message %d: %w", i, err)
+		}
 	}
+	return buf.Bytes(), nil
 }
@@ -2,7 +2,10 @@ package readyroom
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -65,7 +68,7 @@ func TestPlanSpawnsThreeSessionsWithCommissionContext(t *testing.T) {
 		t.Fatalf("iterations = %d, want 2", result.Iterations)
 	}
 
-	for _, role := range requiredRoles {
+	for _, role := range defaultRequiredRoles {
 		session := factory.sessionsByRole[role]
 		if len(session.inputs) == 0 {
 			t.Fatalf("session %s received no input", role)
@@ -78,6 +81,56 @@ func TestPlanSpawnsThreeSessionsWithCommissionContext(t *testing.T) {
 	}
 }
 
+func TestPlanPassesCommissionGlossaryToEverySession(t *testing.T) {
+	t.Parallel()
+
+	signOff := map[int]SessionOutput{
+		1: {Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true}}},
+	}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain:       signOff,
+			RoleCommander:     signOff,
+			RoleDesignOfficer: signOff,
+		},
+	}
+	comm, err := commission.ParseMarkdown(context.Background(), "Glossary PRD", `
+## Use Cases
+
+| UC ID | Title |
+|-------|-------|
+| UC-1 | Plan waves |
+
+## Glossary
+
+- Wave: A set of missions that can run in parallel.
+`)
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+	comm.ID = "COMM-1"
+
+	room, err := New(factory, *comm, 1)
+	if err != nil {
+		t.Fatalf("new ready room: %v", err)
+	}
+	if _, err := room.Plan(context.Background()); err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	for _, role := range defaultRequiredRoles {
+		session := factory.sessionsByRole[role]
+		if len(session.inputs) == 0 {
+			t.Fatalf("session %s received no input", role)
+		}
+		for _, input := range session.inputs {
+			if got := input.Glossary["Wave"]; got != "A set of missions that can run in parallel." {
+				t.Fatalf("session %s glossary = %#v, want Wave definition", role, input.Glossary)
+			}
+		}
+	}
+}
+
 func TestPlanRoutesStructuredMessagesThroughOrchestrator(t *testing.T) {
 	t.Parallel()
 
@@ -156,6 +209,65 @@ func TestPlanRoutesStructuredMessagesThroughOrchestrator(t *testing.T) {
 	}
 }
 
+func TestPlanConcurrentSessionsMatchesSequentialResult(t *testing.T) {
+	t.Parallel()
+
+	scripts := func() map[AgentRole]map[int]SessionOutput {
+		return map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {
+					Missions: []MissionContribution{{MissionID: "M-1", Title: "Parser", UseCaseIDs: []string{"UC-1"}, SignOff: true}},
+					Messages: []ReadyRoomMessage{{To: string(RoleCommander), Type: "analysis", Content: "captain->commander"}},
+				},
+				2: {
+					Missions: []MissionContribution{{MissionID: "M-2", UseCaseIDs: []string{"UC-2"}, SignOff: true}},
+				},
+			},
+			RoleCommander: {
+				1: {
+					Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true}},
+					Messages: []ReadyRoomMessage{{To: "broadcast", Type: "feedback", Content: "commander-broadcast"}},
+				},
+				2: {
+					Missions: []MissionContribution{{MissionID: "M-2", UseCaseIDs: []string{"UC-2"}, SignOff: true}},
+				},
+			},
+			RoleDesignOfficer: {
+				1: {
+					Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true}},
+				},
+				2: {
+					Missions: []MissionContribution{{MissionID: "M-2", Title: "Renderer", UseCaseIDs: []string{"UC-2"}, SignOff: true}},
+					Messages: []ReadyRoomMessage{{To: string(RoleCaptain), Type: "analysis", Content: "design->captain"}},
+				},
+			},
+		}
+	}
+	fixedTime := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	plan := func(concurrent bool) PlanResult {
+		t.Helper()
+		room := newReadyRoomForTest(t, &fakeFactory{scripts: scripts()}, 3)
+		room.now = func() time.Time { return fixedTime }
+		if err := room.SetConcurrentSessions(concurrent); err != nil {
+			t.Fatalf("set concurrent sessions: %v", err)
+		}
+		result, err := room.Plan(context.Background())
+		if err != nil {
+			t.Fatalf("plan (concurrent=%v): %v", concurrent, err)
+		}
+		return result
+	}
+
+	sequential := plan(false)
+	concurrent := plan(true)
+	if !sequential.Consensus || sequential.Iterations != 2 {
+		t.Fatalf("sequential consensus = %v after %d iterations, want consensus in 2", sequential.Consensus, sequential.Iterations)
+	}
+	if !reflect.DeepEqual(sequential, concurrent) {
+		t.Fatalf("concurrent result = %+v\nwant sequential result %+v", concurrent, sequential)
+	}
+}
+
 func TestPlanStopsAtMaxIterationsWithoutConsensus(t *testing.T) {
 	t.Parallel()
 
@@ -190,6 +302,108 @@ func TestPlanStopsAtMaxIterationsWithoutConsensus(t *testing.T) {
 	}
 }
 
+func TestPlanReturnsEarlyWhenSignoffsStall(t *testing.T) {
+	t.Parallel()
+
+	stuck := SessionOutput{Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true}}}
+	scripts := func() map[AgentRole]map[int]SessionOutput {
+		return map[AgentRole]map[int]SessionOutput{
+			RoleCaptain:       {1: stuck, 2: stuck, 3: stuck, 4: stuck, 5: stuck},
+			RoleDesignOfficer: {1: stuck, 2: stuck, 3: stuck, 4: stuck, 5: stuck},
+		}
+	}
+
+	factory := &fakeFactory{scripts: scripts()}
+	room := newReadyRoomForTest(t, factory, 5)
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if result.Consensus {
+		t.Fatal("consensus = true, want false without a commander signoff")
+	}
+	if result.StalledAt != 3 || result.Iterations != 3 {
+		t.Fatalf("stalled at %d after %d iterations, want 3 after two unchanged iterations", result.StalledAt, result.Iterations)
+	}
+	if got := len(factory.sessionsByRole[RoleCaptain].inputs); got != 3 {
+		t.Fatalf("captain executions = %d, want 3", got)
+	}
+
+	disabled := newReadyRoomForTest(t, &fakeFactory{scripts: scripts()}, 5)
+	if err := disabled.SetStallIterations(0); err != nil {
+		t.Fatalf("set stall iterations: %v", err)
+	}
+	result, err = disabled.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan without stall detection: %v", err)
+	}
+	if result.StalledAt != 0 || result.Iterations != 5 {
+		t.Fatalf("stalled at %d after %d iterations, want all 5 iterations with detection disabled", result.StalledAt, result.Iterations)
+	}
+	if err := disabled.SetStallIterations(-1); err == nil {
+		t.Fatal("expected error for negative stall iterations")
+	}
+}
+
+func TestValidateConsensusHonorsCoverageThreshold(t *testing.T) {
+	t.Parallel()
+
+	signed := MissionSignoffs{Captain: true, Commander: true, DesignOfficer: true}
+	tests := []struct {
+		name      string
+		threshold float64
+		want      bool
+	}{
+		{name: "half coverage accepted", threshold: 0.5, want: true},
+		{name: "eighty percent coverage accepted", threshold: 0.8, want: true},
+		{name: "full coverage required", threshold: 1.0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			room, err := New(&fakeFactory{}, commission.Commission{
+				ID: "COMM-1",
+				UseCases: []commission.UseCase{
+					{ID: "UC-1"}, {ID: "UC-2"}, {ID: "UC-3"}, {ID: "UC-4"}, {ID: "UC-5"},
+				},
+			}, 1)
+			if err != nil {
+				t.Fatalf("new ready room: %v", err)
+			}
+			if err := room.SetCoverageThreshold(tt.threshold); err != nil {
+				t.Fatalf("set coverage threshold: %v", err)
+			}
+			room.missionPlan = map[string]*MissionPlan{
+				"M-1": {ID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, Signoffs: signed},
+				"M-2": {ID: "M-2", UseCaseIDs: []string{"UC-3", "UC-4"}, Signoffs: signed},
+			}
+
+			consensus, coverage := room.ValidateConsensus()
+			if consensus != tt.want {
+				t.Fatalf("consensus = %v at threshold %v with 4/5 covered, want %v", consensus, tt.threshold, tt.want)
+			}
+			if got := coverage["UC-5"]; got != CoverageUncovered {
+				t.Fatalf("UC-5 coverage = %q, want the known gap reported as %q", got, CoverageUncovered)
+			}
+
+			// An unsigned mission blocks consensus regardless of coverage.
+			room.missionPlan["M-3"] = &MissionPlan{ID: "M-3", UseCaseIDs: []string{"UC-5"}, Signoffs: MissionSignoffs{Captain: true}}
+			if consensus, _ := room.ValidateConsensus(); consensus {
+				t.Fatalf("consensus reached at threshold %v with an unsigned mission", tt.threshold)
+			}
+		})
+	}
+
+	room := newReadyRoomForTest(t, &fakeFactory{}, 1)
+	for _, threshold := range []float64{0, -0.5, 1.5} {
+		if err := room.SetCoverageThreshold(threshold); err == nil {
+			t.Fatalf("expected error for coverage threshold %v", threshold)
+		}
+	}
+}
+
 func TestBuildUseCaseCoverageTracksCoveredPartialUncovered(t *testing.T) {
 	t.Parallel()
 
@@ -237,6 +451,53 @@ func TestBuildUseCaseCoverageTracksCoveredPartialUncovered(t *testing.T) {
 	}
 }
 
+func TestBuildACCoverageReflectsMissionACRefs(t *testing.T) {
+	t.Parallel()
+
+	room := &ReadyRoom{
+		commission: commission.Commission{
+			ID:                 "COMM-1",
+			AcceptanceCriteria: []commission.AC{{ID: "AC-0"}},
+			UseCases: []commission.UseCase{
+				{ID: "UC-1", AcceptanceCriteria: []commission.AC{{ID: "AC-1"}, {ID: "AC-2"}}},
+				{ID: "UC-2", AcceptanceCriteria: []commission.AC{{ID: "AC-3"}}},
+			},
+		},
+		missionPlan: map[string]*MissionPlan{
+			"M-COVERED": {
+				ID:         "M-COVERED",
+				UseCaseIDs: []string{"UC-1"},
+				ACRefs:     []string{"AC-1", "AC-UNKNOWN"},
+				Signoffs:   MissionSignoffs{Captain: true, Commander: true, DesignOfficer: true},
+			},
+			"M-PARTIAL": {
+				ID:         "M-PARTIAL",
+				UseCaseIDs: []string{"UC-2"},
+				ACRefs:     []string{"AC-3"},
+				Signoffs:   MissionSignoffs{Captain: true},
+			},
+		},
+	}
+
+	// UC-1 counts as covered by use case even though its AC-2 is referenced by no mission.
+	if got := room.BuildUseCaseCoverage()["UC-1"]; got != CoverageCovered {
+		t.Fatalf("UC-1 coverage = %q, want %q", got, CoverageCovered)
+	}
+
+	want := map[string]CoverageState{
+		"AC-0": CoverageUncovered,
+		"AC-1": CoverageCovered,
+		"AC-2": CoverageUncovered,
+		"AC-3": CoveragePartial,
+	}
+	if got := room.BuildACCoverage(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("AC coverage = %v, want %v", got, want)
+	}
+	if got := room.buildResult(1, room.BuildUseCaseCoverage(), false).ACCoverage; !reflect.DeepEqual(got, want) {
+		t.Fatalf("plan result AC coverage = %v, want %v", got, want)
+	}
+}
+
 func TestPlanReturnsErrorForUnknownMessageRecipient(t *testing.T) {
 	t.Parallel()
 
@@ -395,6 +656,398 @@ func TestPlanSuspendsOnQuestionPublishesEventAndRoutesAnswer(t *testing.T) {
 	}
 }
 
+func TestPlanWarnsWhenWithdrawnSignoffRegressesCoverage(t *testing.T) {
+	t.Parallel()
+
+	signed := []MissionContribution{
+		{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true},
+		{MissionID: "M-2", UseCaseIDs: []string{"UC-2"}},
+	}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {Missions: signed},
+				2: {Missions: []MissionContribution{{MissionID: "M-1", WithdrawSignOff: true}}},
+			},
+			RoleCommander:     {1: {Missions: signed}},
+			RoleDesignOfficer: {1: {Missions: signed}},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 2)
+	eventBus := &captureBus{}
+	if err := room.SetEventBus(eventBus); err != nil {
+		t.Fatalf("set event bus: %v", err)
+	}
+
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if result.Consensus {
+		t.Fatal("consensus = true, want false")
+	}
+	if got := result.Coverage["UC-1"]; got != CoveragePartial {
+		t.Fatalf("UC-1 coverage = %q, want %q", got, CoveragePartial)
+	}
+
+	var warnings []events.Event
+	for _, event := range eventBus.snapshot() {
+		if event.Type == events.EventTypeSystemAlert && event.EntityType == "planning_coverage" {
+			warnings = append(warnings, event)
+		}
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("coverage regression warnings = %+v, want 1", warnings)
+	}
+	payload, ok := warnings[0].Payload.(map[string]string)
+	if !ok {
+		t.Fatalf("payload type = %T, want map[string]string", warnings[0].Payload)
+	}
+	if warnings[0].Severity != events.SeverityWarn || payload["iteration"] != "2" || payload["regressions"] != "UC-1 covered->partial" {
+		t.Fatalf("warning = %+v payload = %v, want iteration 2 UC-1 covered->partial", warnings[0], payload)
+	}
+}
+
+func TestPlanDropsQuestionsBeyondRoleBudget(t *testing.T) {
+	t.Parallel()
+
+	question := func(id string) admiral.AdmiralQuestion {
+		return admiral.AdmiralQuestion{
+			QuestionID:    id,
+			Domain:        "functional",
+			QuestionText:  "Should this mission proceed?",
+			Options:       []string{"Proceed", "Hold"},
+			AllowFreeText: true,
+		}
+	}
+	signed := []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, SignOff: true}}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {Questions: []admiral.AdmiralQuestion{question("Q-1")}},
+				2: {Questions: []admiral.AdmiralQuestion{question("Q-2")}, Missions: signed},
+			},
+			RoleCommander:     {2: {Missions: signed}},
+			RoleDesignOfficer: {2: {Missions: signed}},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 2)
+	eventBus := &captureBus{}
+	if err := room.SetEventBus(eventBus); err != nil {
+		t.Fatalf("set event bus: %v", err)
+	}
+	if err := room.SetQuestionBudget(1); err != nil {
+		t.Fatalf("set question budget: %v", err)
+	}
+
+	go func() {
+		asked := <-room.QuestionGate().Questions()
+		if err := room.QuestionGate().SubmitAnswer(admiral.AdmiralAnswer{
+			QuestionID:     asked.QuestionID,
+			SelectedOption: "Proceed",
+		}); err != nil {
+			panic(err)
+		}
+	}()
+
+	resultCh := make(chan PlanResult, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		result, err := room.Plan(context.Background())
+		if err != nil {
+			errCh <- err
+			return
+		}
+		resultCh <- result
+	}()
+
+	var result PlanResult
+	select {
+	case err := <-errCh:
+		t.Fatalf("plan: %v", err)
+	case result = <-resultCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for plan result; over-budget question likely blocked")
+	}
+
+	if !result.Consensus {
+		t.Fatal("consensus = false, want true")
+	}
+	if len(result.QuestionLog) != 1 || result.QuestionLog[0].QuestionID != "Q-1" {
+		t.Fatalf("question log = %+v, want only Q-1", result.QuestionLog)
+	}
+	if got := result.DroppedQuestions[RoleCaptain]; got != 1 {
+		t.Fatalf("dropped captain questions = %d, want 1", got)
+	}
+
+	foundWarning := false
+	for _, event := range eventBus.snapshot() {
+		if event.Type == events.EventTypeAdmiralQuestion && event.EntityID == "Q-2" {
+			t.Fatal("over-budget question Q-2 should not be surfaced to the admiral")
+		}
+		if event.Type == events.EventTypeSystemAlert && event.EntityID == "Q-2" && event.Severity == events.SeverityWarn {
+			foundWarning = true
+		}
+	}
+	if !foundWarning {
+		t.Fatal("expected warning event for dropped question Q-2")
+	}
+}
+
+func TestSetQuestionBudgetRejectsNegative(t *testing.T) {
+	t.Parallel()
+
+	room := newReadyRoomForTest(t, &fakeFactory{}, 1)
+	if err := room.SetQuestionBudget(-1); err == nil {
+		t.Fatal("expected error for negative question budget")
+	}
+}
+
+func TestPlanQuestionTimeoutAppliesDefaultOrSkip(t *testing.T) {
+	t.Parallel()
+
+	signed := []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, SignOff: true}}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {
+					Questions: []admiral.AdmiralQuestion{
+						{
+							QuestionID:    "Q-1",
+							QuestionText:  "Should this mission proceed?",
+							Options:       []string{"Proceed", "Hold"},
+							DefaultOption: "Proceed",
+						},
+						{
+							QuestionID:   "Q-2",
+							QuestionText: "Any naming preference?",
+							AllowSkip:    true,
+						},
+					},
+					Missions: signed,
+				},
+			},
+			RoleCommander:     {1: {Missions: signed}},
+			RoleDesignOfficer: {1: {Missions: signed}},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 1)
+	if err := room.SetQuestionTimeout(20 * time.Millisecond); err != nil {
+		t.Fatalf("set question timeout: %v", err)
+	}
+
+	// Nobody answers, so every question must fall back once its own timeout elapses.
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if !result.Consensus {
+		t.Fatal("consensus = false, want true")
+	}
+	if len(result.QuestionLog) != 2 {
+		t.Fatalf("question log = %+v, want both timed-out questions", result.QuestionLog)
+	}
+	for _, record := range result.QuestionLog {
+		if !record.TimedOut {
+			t.Fatalf("record %s TimedOut = false, want true", record.QuestionID)
+		}
+	}
+	if got := result.QuestionLog[0].Answer.SelectedOption; got != "Proceed" {
+		t.Fatalf("Q-1 selected option = %q, want default Proceed", got)
+	}
+	if !result.QuestionLog[1].Answer.SkipFlag {
+		t.Fatal("Q-2 skip flag = false, want auto-skip")
+	}
+
+	foundAnswer := false
+	for _, message := range room.messages {
+		if message.Type == "admiral_answer" && message.To == string(RoleCaptain) {
+			foundAnswer = true
+		}
+	}
+	if !foundAnswer {
+		t.Fatal("expected timed-out default answer to be routed to the captain")
+	}
+
+	select {
+	case question := <-room.questionGate.Questions():
+		t.Fatalf("timed-out question %s still queued for the Admiral", question.QuestionID)
+	default:
+	}
+	for range 2 {
+		if err := room.questionGate.SubmitAnswer(admiral.AdmiralAnswer{QuestionID: "Q-1", SelectedOption: "Hold"}); !errors.Is(err, admiral.ErrQuestionNotPending) {
+			t.Fatalf("late answer error = %v, want ErrQuestionNotPending", err)
+		}
+	}
+}
+
+func TestPlanQuestionTimeoutWithoutFallbackReturnsErrQuestionTimeout(t *testing.T) {
+	t.Parallel()
+
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {Questions: []admiral.AdmiralQuestion{{
+					QuestionID:   "Q-1",
+					QuestionText: "Which database should missions target?",
+					Options:      []string{"Postgres", "SQLite"},
+				}}},
+			},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 1)
+	if err := room.SetQuestionTimeout(20 * time.Millisecond); err != nil {
+		t.Fatalf("set question timeout: %v", err)
+	}
+
+	_, err := room.Plan(context.Background())
+	if !errors.Is(err, ErrQuestionTimeout) {
+		t.Fatalf("plan error = %v, want ErrQuestionTimeout", err)
+	}
+	if err := room.SetQuestionTimeout(-time.Second); err == nil {
+		t.Fatal("expected error for negative question timeout")
+	}
+}
+
+func TestPlanQuestionTimeoutIsPerQuestion(t *testing.T) {
+	t.Parallel()
+
+	question := func(id string) admiral.AdmiralQuestion {
+		return admiral.AdmiralQuestion{
+			QuestionID:   id,
+			QuestionText: "Should this mission proceed?",
+			Options:      []string{"Proceed", "Hold"},
+		}
+	}
+	signed := []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, SignOff: true}}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {
+				1: {
+					Questions: []admiral.AdmiralQuestion{question("Q-1"), question("Q-2"), question("Q-3")},
+					Missions:  signed,
+				},
+			},
+			RoleCommander:     {1: {Missions: signed}},
+			RoleDesignOfficer: {1: {Missions: signed}},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 1)
+	if err := room.SetQuestionTimeout(200 * time.Millisecond); err != nil {
+		t.Fatalf("set question timeout: %v", err)
+	}
+
+	// Each answer arrives well within the per-question timeout, but together they exceed it.
+	go func() {
+		for range 3 {
+			asked := <-room.QuestionGate().Questions()
+			time.Sleep(80 * time.Millisecond)
+			if err := room.QuestionGate().SubmitAnswer(admiral.AdmiralAnswer{
+				QuestionID:     asked.QuestionID,
+				SelectedOption: "Proceed",
+			}); err != nil {
+				panic(err)
+			}
+		}
+	}()
+
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if len(result.QuestionLog) != 3 {
+		t.Fatalf("question log entries = %d, want 3", len(result.QuestionLog))
+	}
+	for _, record := range result.QuestionLog {
+		if record.TimedOut {
+			t.Fatalf("record %s timed out; timeout should apply per question", record.QuestionID)
+		}
+	}
+}
+
+func TestPlanReachesConsensusWithConfiguredRoles(t *testing.T) {
+	t.Parallel()
+
+	const roleSecurityOfficer AgentRole = "securityOfficer"
+	signOff := SessionOutput{
+		Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, SignOff: true}},
+	}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain:         {1: signOff},
+			RoleCommander:       {1: signOff},
+			roleSecurityOfficer: {1: signOff},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 3)
+	if err := room.SetRequiredRoles([]AgentRole{RoleCaptain, RoleCommander, roleSecurityOfficer}); err != nil {
+		t.Fatalf("set required roles: %v", err)
+	}
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	if !result.Consensus || result.Iterations != 1 {
+		t.Fatalf("consensus = %v after %d iterations, want consensus in 1", result.Consensus, result.Iterations)
+	}
+	if _, spawned := factory.sessionsByRole[RoleDesignOfficer]; spawned {
+		t.Fatal("design officer session spawned, want only the configured roles")
+	}
+	if len(factory.spawnRequests) != 3 {
+		t.Fatalf("spawn requests = %d, want 3", len(factory.spawnRequests))
+	}
+	signoffs := result.Missions[0].Signoffs
+	if !signoffs.Signed(roleSecurityOfficer) || signoffs.DesignOfficer {
+		t.Fatalf("signoffs = %+v, want security officer signed and no design officer", signoffs)
+	}
+}
+
+func TestPlanWithoutConfiguredRoleSignoffLacksConsensus(t *testing.T) {
+	t.Parallel()
+
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain: {1: {
+				Missions: []MissionContribution{{MissionID: "M-1", UseCaseIDs: []string{"UC-1", "UC-2"}, SignOff: true}},
+			}},
+		},
+	}
+
+	room := newReadyRoomForTest(t, factory, 2)
+	if err := room.SetRequiredRoles([]AgentRole{RoleCaptain, RoleCommander}); err != nil {
+		t.Fatalf("set required roles: %v", err)
+	}
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if result.Consensus {
+		t.Fatal("consensus = true, want false while the commander has not signed off")
+	}
+}
+
+func TestSetRequiredRolesValidatesRoles(t *testing.T) {
+	t.Parallel()
+
+	room := newReadyRoomForTest(t, &fakeFactory{}, 1)
+	for name, roles := range map[string][]AgentRole{
+		"empty":     nil,
+		"blank":     {RoleCaptain, " "},
+		"duplicate": {RoleCaptain, RoleCaptain},
+	} {
+		if err := room.SetRequiredRoles(roles); err == nil {
+			t.Fatalf("%s roles: expected error", name)
+		}
+	}
+}
+
 func TestPlanBroadcastsAdmiralAnswerWhenRequested(t *testing.T) {
 	t.Parallel()
 
@@ -475,6 +1128,54 @@ func TestPlanBroadcastsAdmiralAnswerWhenRequested(t *testing.T) {
 	}
 }
 
+func TestPlanMissionsInheritCommissionHarnessDefaults(t *testing.T) {
+	t.Parallel()
+
+	contributions := []MissionContribution{
+		{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true},
+		{MissionID: "M-2", UseCaseIDs: []string{"UC-2"}, SignOff: true},
+	}
+	commanderContributions := []MissionContribution{
+		{MissionID: "M-1", UseCaseIDs: []string{"UC-1"}, SignOff: true},
+		{MissionID: "M-2", UseCaseIDs: []string{"UC-2"}, SignOff: true, Harness: "claude", Model: "opus"},
+	}
+	factory := &fakeFactory{
+		scripts: map[AgentRole]map[int]SessionOutput{
+			RoleCaptain:       {1: {Missions: contributions}},
+			RoleCommander:     {1: {Missions: commanderContributions}},
+			RoleDesignOfficer: {1: {Missions: contributions}},
+		},
+	}
+
+	room, err := New(
+		factory,
+		commission.Commission{
+			ID:             "COMM-1",
+			UseCases:       []commission.UseCase{{ID: "UC-1"}, {ID: "UC-2"}},
+			DefaultHarness: "codex",
+			DefaultModel:   "gpt-5-codex",
+		},
+		1,
+	)
+	if err != nil {
+		t.Fatalf("new ready room: %v", err)
+	}
+
+	result, err := room.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if len(result.Missions) != 2 {
+		t.Fatalf("missions = %d, want 2", len(result.Missions))
+	}
+	if got := result.Missions[0]; got.Harness != "codex" || got.Model != "gpt-5-codex" {
+		t.Fatalf("M-1 harness/model = (%q, %q), want commission defaults (codex, gpt-5-codex)", got.Harness, got.Model)
+	}
+	if got := result.Missions[1]; got.Harness != "claude" || got.Model != "opus" {
+		t.Fatalf("M-2 harness/model = (%q, %q), want mission override (claude, opus)", got.Harness, got.Model)
+	}
+}
+
 func TestPlanClassifiesCommanderMissions(t *testing.T) {
 	t.Parallel()
 
@@ -720,10 +1421,56 @@ func newReadyRoomForTest(t *testing.T, factory *fakeFactory, maxIterations int)
 }
 
 type fakeFactory struct {
+	mu             sync.Mutex
 	scripts        map[AgentRole]map[int]SessionOutput
 	spawnRequests  []SpawnRequest
 	sessionsByRole map[AgentRole]*fakeSession
 	spawnErr       error
+	roleSpawnErrs  map[AgentRole]error
+	inFlight       int
+	maxInFlight    int
+}
+
+func TestSpawnSessionsBoundsParallelismAndNamesFailingRole(t *testing.T) {
+	t.Parallel()
+
+	factory := &fakeFactory{}
+	room, err := New(factory, commission.Commission{ID: "comm-1"}, 1)
+	if err != nil {
+		t.Fatalf("new ready room: %v", err)
+	}
+	if err := room.SetSpawnConcurrency(0); err == nil {
+		t.Fatal("expected non-positive spawn concurrency to be rejected")
+	}
+	if err := room.SetSpawnConcurrency(2); err != nil {
+		t.Fatalf("set spawn concurrency: %v", err)
+	}
+
+	if err := room.spawnSessions(context.Background()); err != nil {
+		t.Fatalf("spawn sessions: %v", err)
+	}
+	if len(room.sessions) != len(defaultRequiredRoles) || len(factory.spawnRequests) != len(defaultRequiredRoles) {
+		t.Fatalf("sessions = %d spawns = %d, want %d each", len(room.sessions), len(factory.spawnRequests), len(defaultRequiredRoles))
+	}
+	if factory.maxInFlight > 2 {
+		t.Fatalf("max concurrent spawns = %d, want at most 2", factory.maxInFlight)
+	}
+
+	failing := &fakeFactory{roleSpawnErrs: map[AgentRole]error{RoleDesignOfficer: fmt.Errorf("harness unavailable")}}
+	room, err = New(failing, commission.Commission{ID: "comm-1"}, 1)
+	if err != nil {
+		t.Fatalf("new ready room: %v", err)
+	}
+	if err := room.SetSpawnConcurrency(3); err != nil {
+		t.Fatalf("set spawn concurrency: %v", err)
+	}
+	err = room.spawnSessions(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "spawn session "+string(RoleDesignOfficer)+": harness unavailable") {
+		t.Fatalf("spawn error = %v, want failure naming %s", err, RoleDesignOfficer)
+	}
+	if _, ok := room.sessions[RoleCaptain]; !ok {
+		t.Fatal("expected successful roles to keep their sessions")
+	}
 }
 
 type fakeMissionClassifier struct {
@@ -742,9 +1489,21 @@ func (f *fakeMissionClassifier) ClassifyMission(
 }
 
 func (f *fakeFactory) Spawn(_ context.Context, request SpawnRequest) (Session, error) {
+	f.mu.Lock()
+	f.inFlight++
+	f.maxInFlight = max(f.maxInFlight, f.inFlight)
+	f.mu.Unlock()
+	time.Sleep(time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.inFlight--
 	if f.spawnErr != nil {
 		return nil, f.spawnErr
 	}
+	if err := f.roleSpawnErrs[request.Role]; err != nil {
+		return nil, err
+	}
 
 	if f.sessionsByRole == nil {
 		f.sessionsByRole = make(map[AgentRole]*fakeSession)
@@ -809,6 +1568,8 @@ func (b *captureBus) Publish(event events.Event) {
 	b.events = append(b.events, event)
 }
 
+func (b *captureBus) SubscriberStats() []events.SubscriberStat { return nil }
+
 func (b *captureBus) snapshot() []events.Event {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -816,3 +1577,41 @@ func (b *captureBus) snapshot() []events.Event {
 	copy(out, b.events)
 	return out
 }
+
+func TestExportMessagesProducesRoundTrippableJSONL(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 2, 10, 9, 30, 0, 0, time.UTC)
+	result := PlanResult{Messages: []ReadyRoomMessage{
+		{From: "captain", To: "commander", Type: "proposal", Domain: "functional", Content: "split auth", Timestamp: base},
+		{From: "commander", To: "all", Type: "feedback", Content: "line one\nline two", Timestamp: base.Add(time.Second)},
+		{From: "designOfficer", To: "captain", Type: "question", Domain: "design", Content: `needs "quotes"`, Timestamp: base.Add(2 * time.Second)},
+	}}
+
+	data, err := ExportMessages(result)
+	if err != nil {
+		t.Fatalf("export messages: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != len(result.Messages) {
+		t.Fatalf("line count = %d, want %d: %q", len(lines), len(result.Messages), data)
+	}
+	for i, line := range lines {
+		var decoded ReadyRoomMessage
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Fatalf("decode line %d: %v", i, err)
+		}
+		if !reflect.DeepEqual(decoded, result.Messages[i]) {
+			t.Fatalf("line %d = %+v, want %+v", i, decoded, result.Messages[i])
+		}
+	}
+
+	empty, err := ExportMessages(PlanResult{})
+	if err != nil {
+		t.Fatalf("export empty: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Fatalf("empty export = %q, want no output", empty)
+	}
+}
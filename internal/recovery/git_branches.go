@@ -0,0 +1,106 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MissionBranchPrefix is the prefix shared by all mission worktree branches.
+const MissionBranchPrefix = "feature/MISSION-"
+
+var nonBranchSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// GitBranchManager lists and deletes mission worktree branches in the current repository.
+type GitBranchManager struct {
+	runner CommandRunner
+}
+
+// NewGitBranchManager creates a git branch manager.
+func NewGitBranchManager() (*GitBranchManager, error) {
+	return NewGitBranchManagerWithRunner(defaultCommandRunner{})
+}
+
+// NewGitBranchManagerWithRunner creates a git branch manager with a custom runner.
+func NewGitBranchManagerWithRunner(runner CommandRunner) (*GitBranchManager, error) {
+	if runner == nil {
+		return nil, errors.New("runner must not be nil")
+	}
+	return &GitBranchManager{runner: runner}, nil
+}
+
+// MissionBranches returns all local branches matching the mission branch pattern.
+func (m *GitBranchManager) MissionBranches(ctx context.Context) ([]string, error) {
+	if m == nil {
+		return nil, errors.New("git branch manager is nil")
+	}
+	out, err := m.runner.Run(ctx, "git", "branch", "--list", MissionBranchPrefix+"*", "--format=%(refname:short)")
+	if err != nil {
+		return nil, fmt.Errorf("list mission branches: %w", err)
+	}
+
+	branches := make([]string, 0)
+	for _, line := range strings.Split(string(out), "\n") {
+		branch := strings.TrimSpace(line)
+		if !strings.HasPrefix(branch, MissionBranchPrefix) {
+			continue
+		}
+		branches = append(branches, branch)
+	}
+	return branches, nil
+}
+
+// CheckedOutBranches returns local branches checked out in a worktree, keyed to the worktree path.
+func (m *GitBranchManager) CheckedOutBranches(ctx context.Context) (map[string]string, error) {
+	if m == nil {
+		return nil, errors.New("git branch manager is nil")
+	}
+	out, err := m.runner.Run(ctx, "git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, fmt.Errorf("list worktrees: %w", err)
+	}
+
+	checkedOut := make(map[string]string)
+	worktreePath := ""
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "worktree "):
+			worktreePath = strings.TrimPrefix(line, "worktree ")
+		case strings.HasPrefix(line, "branch "):
+			checkedOut[strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")] = worktreePath
+		}
+	}
+	return checkedOut, nil
+}
+
+// DeleteBranch deletes a local branch; git refuses branches that are not fully merged.
+func (m *GitBranchManager) DeleteBranch(ctx context.Context, branch string) error {
+	if m == nil {
+		return errors.New("git branch manager is nil")
+	}
+	branch = strings.TrimSpace(branch)
+	if branch == "" {
+		return errors.New("branch must not be empty")
+	}
+	if _, err := m.runner.Run(ctx, "git", "branch", "-d", branch); err != nil {
+		return fmt.Errorf("delete branch %s: %w", branch, err)
+	}
+	return nil
+}
+
+// missionBranchPrefix mirrors the commander's branch naming: feature/MISSION-<slug(id)>-<slug(title)>.
+func missionBranchPrefix(missionID string) string {
+	token := strings.ToLower(strings.TrimSpace(missionID))
+	token = strings.ReplaceAll(token, "_", "-")
+	token = nonBranchSlugChars.ReplaceAllString(token, "-")
+	token = strings.Trim(token, "-")
+	if token == "" || token == "mission" {
+		token = "unknown"
+	}
+	return MissionBranchPrefix + token + "-"
+}
+
+var _ BranchManager = (*GitBranchManager)(nil)
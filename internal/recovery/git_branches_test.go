@@ -0,0 +1,132 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRecoverReportsOnlyOrphanedMissionBranches(t *testing.T) {
+	t.Parallel()
+
+	for _, autoRemediate := range []bool{false, true} {
+		runner := &fakeTmuxRunner{
+			outputs: map[string][]byte{"branch": []byte(
+				"feature/MISSION-m-1-login-flow\n" +
+					"feature/MISSION-m-2-retry-queue\n" +
+					"feature/MISSION-m-3-crashed-run\n" +
+					"feature/MISSION-m-10-unrelated\n",
+			), "worktree": []byte(
+				"worktree /repo\nHEAD abc123\nbranch refs/heads/main\n\n" +
+					"worktree /repo/.beads/worktrees/MISSION-m-3\nHEAD def456\nbranch refs/heads/feature/MISSION-m-3-crashed-run\n",
+			)},
+			errors: map[string]error{},
+		}
+		branches, err := NewGitBranchManagerWithRunner(runner)
+		if err != nil {
+			t.Fatalf("new branch manager: %v", err)
+		}
+		store := &fakeStateStore{
+			snapshot: Snapshot{
+				Missions: []Mission{
+					{ID: "M_1", State: MissionInProgress},
+					{ID: "m-2", State: MissionBacklog},
+					{ID: "m-3", State: MissionDone},
+				},
+			},
+		}
+		bus := &fakeBus{}
+		manager, err := NewManager(store, &fakeSessionManager{}, Config{
+			EventBus:      bus,
+			Branches:      branches,
+			AutoRemediate: autoRemediate,
+		})
+		if err != nil {
+			t.Fatalf("new manager: %v", err)
+		}
+
+		result, err := manager.Recover(context.Background())
+		if err != nil {
+			t.Fatalf("recover: %v", err)
+		}
+
+		wantOrphans := []string{"feature/MISSION-m-3-crashed-run", "feature/MISSION-m-10-unrelated"}
+		if !reflect.DeepEqual(result.OrphanedBranches, wantOrphans) {
+			t.Fatalf("auto=%v orphaned branches = %v, want %v", autoRemediate, result.OrphanedBranches, wantOrphans)
+		}
+
+		deleted := make([]string, 0)
+		for _, call := range runner.calls {
+			if len(call) == 3 && call[1] == "-d" {
+				deleted = append(deleted, call[2])
+			}
+		}
+		wantDeleted := []string{}
+		wantFailures := map[string]string{}
+		if autoRemediate {
+			wantDeleted = []string{"feature/MISSION-m-10-unrelated"}
+			wantFailures["feature/MISSION-m-3-crashed-run"] = "checked out in worktree /repo/.beads/worktrees/MISSION-m-3"
+		}
+		if !reflect.DeepEqual(deleted, wantDeleted) {
+			t.Fatalf("auto=%v deleted branches = %v, want %v", autoRemediate, deleted, wantDeleted)
+		}
+		if !reflect.DeepEqual(result.BranchFailures, wantFailures) {
+			t.Fatalf("auto=%v branch failures = %v, want %v", autoRemediate, result.BranchFailures, wantFailures)
+		}
+	}
+}
+
+func TestRecoverReportsBranchDeleteFailuresWithoutFailingRecovery(t *testing.T) {
+	t.Parallel()
+
+	branches := &fakeBranchManager{
+		branches:   []string{"feature/MISSION-m-1-unmerged", "feature/MISSION-m-2-merged"},
+		deleteErrs: map[string]error{"feature/MISSION-m-1-unmerged": errors.New("branch is not fully merged")},
+	}
+	store := &fakeStateStore{snapshot: Snapshot{
+		Commissions: []Commission{{ID: "c-1", State: CommissionExecuting}},
+		Missions:    []Mission{{ID: "m-1", State: MissionHalted}, {ID: "m-2", State: MissionDone}},
+	}}
+	manager, err := NewManager(store, &fakeSessionManager{}, Config{Branches: branches, AutoRemediate: true})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	result, err := manager.Recover(context.Background())
+	if err != nil {
+		t.Fatalf("recover: %v", err)
+	}
+	if !reflect.DeepEqual(branches.deleted, []string{"feature/MISSION-m-2-merged"}) {
+		t.Fatalf("deleted branches = %v, want only the merged branch", branches.deleted)
+	}
+	if reason := result.BranchFailures["feature/MISSION-m-1-unmerged"]; !strings.Contains(reason, "not fully merged") {
+		t.Fatalf("branch failures = %v, want unmerged branch reported", result.BranchFailures)
+	}
+	if !reflect.DeepEqual(result.ResumeCommissionIDs, []string{"c-1"}) {
+		t.Fatalf("resume commissions = %v, want recovery to finish", result.ResumeCommissionIDs)
+	}
+}
+
+type fakeBranchManager struct {
+	branches   []string
+	deleteErrs map[string]error
+	deleted    []string
+}
+
+func (f *fakeBranchManager) MissionBranches(_ context.Context) ([]string, error) {
+	return append([]string(nil), f.branches...), nil
+}
+
+func (f *fakeBranchManager) CheckedOutBranches(_ context.Context) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (f *fakeBranchManager) DeleteBranch(_ context.Context, branch string) error {
+	if err := f.deleteErrs[branch]; err != nil {
+		return err
+	}
+	f.deleted = append(f.deleted, branch)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -67,6 +68,12 @@ type Result struct {
 	Snapshot            Snapshot
 	OrphanedMissionIDs  []string
 	CleanedDeadSessions []string
+	// OrphanedBranches lists mission branches with no active mission; they are
+	// deleted only when AutoRemediate is enabled.
+	OrphanedBranches []string
+	// BranchFailures maps orphaned branches AutoRemediate left in place to the reason:
+	// the branch is checked out in a worktree or git refused to delete it (e.g. unmerged).
+	BranchFailures      map[string]string
 	ResumeCommissionIDs []string
 	RecoveryDuration    time.Duration
 }
@@ -84,6 +91,15 @@ type SessionManager interface {
 	CleanupDeadSession(ctx context.Context, sessionID string) error
 }
 
+// BranchManager lists and deletes mission worktree branches.
+type BranchManager interface {
+	MissionBranches(ctx context.Context) ([]string, error)
+	// CheckedOutBranches maps branches checked out in a worktree to the worktree path.
+	CheckedOutBranches(ctx context.Context) (map[string]string, error)
+	// DeleteBranch deletes a branch, refusing branches that are not fully merged.
+	DeleteBranch(ctx context.Context, branch string) error
+}
+
 // EventBus publishes recovery audit events.
 type EventBus interface {
 	Publish(event events.Event)
@@ -93,6 +109,11 @@ type EventBus interface {
 type Config struct {
 	ResumeTimeout time.Duration
 	EventBus      EventBus
+	// Branches enables the orphaned mission branch scan when set.
+	Branches BranchManager
+	// AutoRemediate deletes orphaned mission branches instead of only reporting them.
+	// Branches checked out in a worktree or not fully merged are kept and reported.
+	AutoRemediate bool
 }
 
 // Manager reconstructs persisted state and repairs orphaned execution state.
@@ -100,6 +121,8 @@ type Manager struct {
 	store         StateStore
 	sessions      SessionManager
 	bus           EventBus
+	branches      BranchManager
+	autoRemediate bool
 	resumeTimeout time.Duration
 	now           func() time.Time
 }
@@ -119,6 +142,8 @@ func NewManager(store StateStore, sessions SessionManager, cfg Config) (*Manager
 		store:         store,
 		sessions:      sessions,
 		bus:           cfg.EventBus,
+		branches:      cfg.Branches,
+		autoRemediate: cfg.AutoRemediate,
 		resumeTimeout: cfg.ResumeTimeout,
 		now:           time.Now,
 	}, nil
@@ -170,6 +195,13 @@ func (m *Manager) Recover(ctx context.Context) (Result, error) {
 	}
 	result.CleanedDeadSessions = cleanedSessions
 
+	orphanedBranches, branchFailures, err := m.recoverOrphanedBranches(ctx, snapshot.Missions, auditTimestamp)
+	if err != nil {
+		return Result{}, err
+	}
+	result.OrphanedBranches = orphanedBranches
+	result.BranchFailures = branchFailures
+
 	result.ResumeCommissionIDs = commissionsToResume(snapshot.Commissions)
 	result.RecoveryDuration = m.now().Sub(started)
 	if err := validateRecoveryDuration(result.RecoveryDuration, m.resumeTimeout); err != nil {
@@ -308,6 +340,82 @@ func (m *Manager) cleanupDeadSessions(
 	return cleanedSessionIDs, nil
 }
 
+func (m *Manager) recoverOrphanedBranches(
+	ctx context.Context,
+	missions []Mission,
+	auditTimestamp time.Time,
+) ([]string, map[string]string, error) {
+	orphanedBranches := make([]string, 0)
+	failures := map[string]string{}
+	if m.branches == nil {
+		return orphanedBranches, failures, nil
+	}
+	branches, err := m.branches.MissionBranches(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list mission branches: %w", err)
+	}
+	checkedOut := map[string]string{}
+	if m.autoRemediate {
+		checkedOut, err = m.branches.CheckedOutBranches(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list checked out branches: %w", err)
+		}
+	}
+
+	activePrefixes := make([]string, 0, len(missions))
+	for _, mission := range missions {
+		if isActiveMission(mission) {
+			activePrefixes = append(activePrefixes, missionBranchPrefix(mission.ID))
+		}
+	}
+
+	for _, branch := range branches {
+		if branchHasActiveMission(branch, activePrefixes) {
+			continue
+		}
+		payload := map[string]string{"action": "report_orphaned_branch"}
+		if m.autoRemediate {
+			payload["action"] = "delete_orphaned_branch"
+			if reason := m.deleteOrphanedBranch(ctx, branch, checkedOut); reason != "" {
+				failures[branch] = reason
+				payload["action"] = "keep_orphaned_branch"
+				payload["reason"] = reason
+			}
+		}
+		orphanedBranches = append(orphanedBranches, branch)
+		m.publishAuditEvent(events.Event{
+			Type:       events.EventTypeHealthCheck,
+			Timestamp:  auditTimestamp,
+			EntityType: "branch",
+			EntityID:   branch,
+			Payload:    payload,
+			Severity:   events.SeverityWarn,
+		})
+	}
+	return orphanedBranches, failures, nil
+}
+
+// deleteOrphanedBranch deletes branch unless a worktree still has it checked out, returning
+// why the branch was kept or "" once it is deleted.
+func (m *Manager) deleteOrphanedBranch(ctx context.Context, branch string, checkedOut map[string]string) string {
+	if worktreePath, ok := checkedOut[branch]; ok {
+		return fmt.Sprintf("checked out in worktree %s", worktreePath)
+	}
+	if err := m.branches.DeleteBranch(ctx, branch); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func branchHasActiveMission(branch string, activePrefixes []string) bool {
+	for _, prefix := range activePrefixes {
+		if strings.HasPrefix(branch, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func commissionsToResume(commissions []Commission) []string {
 	resumeCommissionIDs := make([]string, 0)
 	for _, commission := range commissions {
@@ -334,6 +442,12 @@ func isInProgressMission(m Mission) bool {
 	return state == MissionInProgress
 }
 
+// isActiveMission reports whether a mission may still use its worktree branch.
+func isActiveMission(m Mission) bool {
+	state := strings.ToLower(strings.TrimSpace(m.State))
+	return state != MissionDone && state != MissionHalted
+}
+
 func hasLiveAgentSession(agent Agent, activeSessions map[string]struct{}) bool {
 	if !isActiveAgentState(agent.State) {
 		return false
@@ -363,6 +477,8 @@ func (m *Manager) publishRecoverySummary(result Result, auditTimestamp time.Time
 		Payload: map[string]any{
 			"orphaned_mission_ids":   append([]string(nil), result.OrphanedMissionIDs...),
 			"cleaned_dead_sessions":  append([]string(nil), result.CleanedDeadSessions...),
+			"orphaned_branches":      append([]string(nil), result.OrphanedBranches...),
+			"branch_failures":        maps.Clone(result.BranchFailures),
 			"resume_commission_ids":  append([]string(nil), result.ResumeCommissionIDs...),
 			"recovery_duration_msec": result.RecoveryDuration.Milliseconds(),
 		},
@@ -18,7 +18,7 @@ import (
 const maxErrorMessageBytes = 512
 
 var (
-	sensitiveInlinePattern = regexp.MustCompile(`(?i)(api[_-]?key|token|password|secret|authorization)\s*[:=]\s*([^\s,;]+)`)
+	sensitiveInlinePattern = regexp.MustCompile(`(?i)(api[_-]?key|token|password|secret|authorization)\s*[:=]\s*((?:bearer\s+)?[^\s,;]+)`)
 	bearerTokenPattern     = regexp.MustCompile(`(?i)\bbearer\s+[a-z0-9._\-]+`)
 	openAITokenPattern     = regexp.MustCompile(`\bsk-[A-Za-z0-9]{10,}\b`)
 )
@@ -224,19 +224,24 @@ func hashPrompt(prompt string) string {
 }
 
 func redactSecrets(input string) string {
-	redacted := strings.TrimSpace(input)
+	redacted := MaskSecrets(strings.TrimSpace(input))
 	if redacted == "" {
 		return ""
 	}
-	redacted = sensitiveInlinePattern.ReplaceAllString(redacted, "$1=<redacted>")
-	redacted = bearerTokenPattern.ReplaceAllString(redacted, "bearer <redacted>")
-	redacted = openAITokenPattern.ReplaceAllString(redacted, "<redacted>")
 	if len(redacted) > maxErrorMessageBytes {
 		return redacted[:maxErrorMessageBytes-len("...[truncated]")] + "...[truncated]"
 	}
 	return redacted
 }
 
+// MaskSecrets replaces secret-like values (key=value credentials, bearer tokens, API keys)
+// with a redaction marker while leaving the rest of the text intact.
+func MaskSecrets(input string) string {
+	masked := sensitiveInlinePattern.ReplaceAllString(input, "$1=<redacted>")
+	masked = bearerTokenPattern.ReplaceAllString(masked, "bearer <redacted>")
+	return openAITokenPattern.ReplaceAllString(masked, "<redacted>")
+}
+
 func normalizeOrUnknown(value string) string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -0,0 +1,201 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// LogsEndpointEnv configures the full OTLP HTTP logs endpoint URL.
+	LogsEndpointEnv = "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"
+	// LogScopeName is the instrumentation scope attached to exported log records.
+	LogScopeName = "sc3/runtime"
+)
+
+var logExporterFactory = func(ctx context.Context, endpoint string) (sdklog.Exporter, error) {
+	opts := []otlploghttp.Option{otlploghttp.WithEndpointURL(endpoint)}
+	certPath := strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_CERTIFICATE"))
+	if certPath != "" {
+		tlsConfig, err := tlsConfigFromCertificate(certPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, otlploghttp.WithTLSClientConfig(tlsConfig))
+	}
+	return otlploghttp.New(ctx, opts...)
+}
+
+// LogsEndpoint returns the configured OTLP logs endpoint, or "" when log export is disabled.
+// The environment variable takes precedence over `[otel] logs_endpoint` in config files.
+func LogsEndpoint() string {
+	if endpoint := strings.TrimSpace(os.Getenv(LogsEndpointEnv)); endpoint != "" {
+		return endpoint
+	}
+	return valueFromConfig(logsEndpointFromConfigPath)
+}
+
+func logsEndpointFromConfigPath(path string) (string, error) {
+	decoded, err := decodeTelemetryConfig(path)
+	if err != nil || decoded == nil {
+		return "", err
+	}
+	if decoded.OTEL.LogsEndpoint != nil {
+		return strings.TrimSpace(*decoded.OTEL.LogsEndpoint), nil
+	}
+	return "", nil
+}
+
+// LogExporter converts JSON runtime log lines into OTLP log records and exports them over HTTP.
+// It implements io.Writer so it can be attached as an additional runtime logger sink. Records
+// are handed to the OpenTelemetry batch log processor, which exports in the background and
+// drops the oldest queued records when the collector cannot keep up.
+type LogExporter struct {
+	provider *sdklog.LoggerProvider
+	logger   otellog.Logger
+}
+
+// NewLogExporter creates a batching OTLP HTTP log exporter for endpoint.
+func NewLogExporter(endpoint string) (*LogExporter, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return nil, fmt.Errorf("logs endpoint must not be empty")
+	}
+	exporter, err := logExporterFactory(context.Background(), endpoint)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", ServiceName),
+			attribute.String("service.version", resolveServiceVersion()),
+			attribute.String("environment", resolveEnvironment()),
+		)),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(
+			exporter,
+			sdklog.WithExportInterval(BatchTimeout),
+			sdklog.WithExportMaxBatchSize(BatchSize),
+		)),
+	)
+	return &LogExporter{
+		provider: provider,
+		logger:   provider.Logger(LogScopeName),
+	}, nil
+}
+
+// Write parses newline-delimited JSON log records and queues them for export.
+// It never blocks on the collector and never fails, so file logging is unaffected by outages.
+func (e *LogExporter) Write(p []byte) (int, error) {
+	if e == nil {
+		return len(p), nil
+	}
+	for _, line := range bytes.Split(p, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		ctx, record, ok := parseLogLine(line)
+		if !ok {
+			continue
+		}
+		e.logger.Emit(ctx, record)
+	}
+	return len(p), nil
+}
+
+// Flush exports all queued log records.
+func (e *LogExporter) Flush(ctx context.Context) error {
+	if e == nil {
+		return nil
+	}
+	return e.provider.ForceFlush(ctx)
+}
+
+// Shutdown flushes remaining records and stops background export.
+func (e *LogExporter) Shutdown(ctx context.Context) error {
+	if e == nil {
+		return nil
+	}
+	return e.provider.Shutdown(ctx)
+}
+
+// OTLP severities for the levels emitted by the runtime logger.
+var severities = map[string]otellog.Severity{
+	"debug": otellog.SeverityDebug,
+	"info":  otellog.SeverityInfo,
+	"warn":  otellog.SeverityWarn,
+	"error": otellog.SeverityError,
+	"fatal": otellog.SeverityFatal,
+}
+
+// parseLogLine converts one JSON log line into a log record and a context carrying the line's
+// trace correlation IDs, which the log SDK copies onto the exported record.
+func parseLogLine(line []byte) (context.Context, otellog.Record, bool) {
+	var fields map[string]any
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return nil, otellog.Record{}, false
+	}
+
+	var record otellog.Record
+	record.SetObservedTimestamp(time.Now())
+	if raw, ok := fields["time"].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+			record.SetTimestamp(parsed)
+		}
+	}
+	if level, ok := fields["level"].(string); ok {
+		level = strings.ToLower(strings.TrimSpace(level))
+		record.SetSeverityText(strings.ToUpper(level))
+		record.SetSeverity(severities[level])
+	}
+	if msg, ok := fields["msg"].(string); ok {
+		record.SetBody(otellog.StringValue(msg))
+	}
+
+	var spanConfig trace.SpanContextConfig
+	if raw, ok := fields["trace_id"].(string); ok && isHexID(raw, 32) {
+		spanConfig.TraceID, _ = trace.TraceIDFromHex(raw)
+	}
+	if raw, ok := fields["span_id"].(string); ok && isHexID(raw, 16) {
+		spanConfig.SpanID, _ = trace.SpanIDFromHex(raw)
+	}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(spanConfig))
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		switch key {
+		case "time", "level", "msg":
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		record.AddAttributes(otellog.String(key, fmt.Sprint(fields[key])))
+	}
+	return ctx, record, true
+}
+
+func isHexID(value string, length int) bool {
+	if len(value) != length || strings.Trim(value, "0") == "" {
+		return false
+	}
+	for _, r := range value {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+	return true
+}
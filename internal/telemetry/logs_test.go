@@ -0,0 +1,181 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/logging"
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLogExporterExportsRecordsWithTraceCorrelation(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var (
+		mu       sync.Mutex
+		requests []*collectorlogs.ExportLogsServiceRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request body: %v", err)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/x-protobuf" {
+			t.Errorf("content type = %q, want application/x-protobuf", got)
+		}
+		decoded := &collectorlogs.ExportLogsServiceRequest{}
+		if err := proto.Unmarshal(body, decoded); err != nil {
+			t.Errorf("decode OTLP payload: %v", err)
+		}
+		mu.Lock()
+		requests = append(requests, decoded)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	exporter, err := NewLogExporter(server.URL + "/v1/logs")
+	if err != nil {
+		t.Fatalf("new log exporter: %v", err)
+	}
+
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+	logger, err := logging.New(
+		context.Background(),
+		logging.WithRunID("run-123"),
+		logging.WithTraceID(traceID),
+		logging.WithSpanID(spanID),
+		logging.WithExportWriter(exporter),
+	)
+	if err != nil {
+		t.Fatalf("new logger: %v", err)
+	}
+	logger.Logger.Warn("mission dispatched", "mission_id", "m-1")
+	if err := logger.Close(); err != nil {
+		t.Fatalf("close logger: %v", err)
+	}
+	if err := exporter.Shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown exporter: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var found *logspb.LogRecord
+	for _, request := range requests {
+		for _, resourceLogs := range request.GetResourceLogs() {
+			for _, scopeLogs := range resourceLogs.GetScopeLogs() {
+				if scopeLogs.GetScope().GetName() != LogScopeName {
+					t.Fatalf("scope name = %q, want %q", scopeLogs.GetScope().GetName(), LogScopeName)
+				}
+				for _, record := range scopeLogs.GetLogRecords() {
+					if record.GetBody().GetStringValue() == "mission dispatched" {
+						found = record
+					}
+				}
+			}
+		}
+	}
+	if found == nil {
+		t.Fatalf("exported requests %+v do not contain the mission log record", requests)
+	}
+	if got := hex.EncodeToString(found.GetTraceId()); got != traceID {
+		t.Fatalf("traceId = %q, want %q", got, traceID)
+	}
+	if got := hex.EncodeToString(found.GetSpanId()); got != spanID {
+		t.Fatalf("spanId = %q, want %q", got, spanID)
+	}
+	if found.GetSeverityText() != "WARN" || found.GetSeverityNumber() != logspb.SeverityNumber_SEVERITY_NUMBER_WARN {
+		t.Fatalf("severity = %s/%d, want WARN/13", found.GetSeverityText(), found.GetSeverityNumber())
+	}
+	attributes := make(map[string]string, len(found.GetAttributes()))
+	for _, attribute := range found.GetAttributes() {
+		attributes[attribute.GetKey()] = attribute.GetValue().GetStringValue()
+	}
+	for key, want := range map[string]string{
+		"run_id":     "run-123",
+		"trace_id":   traceID,
+		"span_id":    spanID,
+		"mission_id": "m-1",
+	} {
+		if attributes[key] != want {
+			t.Fatalf("attribute %s = %q, want %q (attributes=%v)", key, attributes[key], want, attributes)
+		}
+	}
+}
+
+func TestLogExporterWriteDoesNotBlockOnSlowCollector(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	exporter, err := NewLogExporter(server.URL + "/v1/logs")
+	if err != nil {
+		t.Fatalf("new log exporter: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_ = exporter.Shutdown(ctx)
+	}()
+
+	line := []byte(`{"time":"2026-01-02T03:04:05Z","level":"info","msg":"tick"}` + "\n")
+	started := time.Now()
+	for range 4 * BatchSize {
+		if _, err := exporter.Write(line); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("writes took %s while the collector was stalled, want them to stay off the export path", elapsed)
+	}
+}
+
+func TestLogsEndpointDisabledUnlessConfigured(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(t.TempDir())
+	t.Setenv(LogsEndpointEnv, "")
+
+	if got := LogsEndpoint(); got != "" {
+		t.Fatalf("logs endpoint = %q, want disabled", got)
+	}
+
+	configDir := filepath.Join(home, ".sc3")
+	if err := os.MkdirAll(configDir, 0o750); err != nil {
+		t.Fatalf("mkdir config dir: %v", err)
+	}
+	config := "[otel]\nlogs_endpoint = \"http://collector:4318/v1/logs\"\n"
+	if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if got := LogsEndpoint(); got != "http://collector:4318/v1/logs" {
+		t.Fatalf("logs endpoint = %q, want config value", got)
+	}
+
+	t.Setenv(LogsEndpointEnv, "http://env:4318/v1/logs")
+	if got := LogsEndpoint(); got != "http://env:4318/v1/logs" {
+		t.Fatalf("logs endpoint = %q, want env value", got)
+	}
+}
@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// CommanderMeterName is the instrumentation scope for commander execution metrics.
+const CommanderMeterName = "sc3/commander"
+
+// CommanderMetrics records mission outcomes and wave durations as OpenTelemetry instruments.
+// It satisfies commander.MetricsRecorder.
+type CommanderMetrics struct {
+	missions     metric.Int64Counter
+	revisions    metric.Int64Histogram
+	missionDurMS metric.Float64Histogram
+	waveDurMS    metric.Float64Histogram
+}
+
+// NewCommanderMetrics creates commander instruments on meter, or on the global meter provider
+// when meter is nil.
+func NewCommanderMetrics(meter metric.Meter) (*CommanderMetrics, error) {
+	if meter == nil {
+		meter = otel.Meter(CommanderMeterName)
+	}
+
+	missions, err := meter.Int64Counter(
+		"sc3.commander.missions",
+		metric.WithDescription("Missions that reached a terminal outcome."),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create missions counter: %w", err)
+	}
+	revisions, err := meter.Int64Histogram(
+		"sc3.commander.mission.revisions",
+		metric.WithDescription("Revision count of missions at their terminal outcome."),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create revisions histogram: %w", err)
+	}
+	missionDurMS, err := meter.Float64Histogram(
+		"sc3.commander.mission.duration_ms",
+		metric.WithDescription("Duration from mission start to its terminal outcome."),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create mission duration histogram: %w", err)
+	}
+	waveDurMS, err := meter.Float64Histogram(
+		"sc3.commander.wave.duration_ms",
+		metric.WithDescription("Duration of one wave's execution."),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create wave duration histogram: %w", err)
+	}
+
+	return &CommanderMetrics{
+		missions:     missions,
+		revisions:    revisions,
+		missionDurMS: missionDurMS,
+		waveDurMS:    waveDurMS,
+	}, nil
+}
+
+// RecordMissionOutcome records one terminal mission outcome. The mission ID is not recorded as
+// an attribute: every mission would start a new time series, so outcomes are the only dimension.
+func (m *CommanderMetrics) RecordMissionOutcome(_ string, outcome string, revisions int, dur time.Duration) {
+	if m == nil {
+		return
+	}
+	if revisions < 0 {
+		revisions = 0
+	}
+	attrs := metric.WithAttributes(attribute.String("outcome", normalizeOrUnknown(outcome)))
+	ctx := context.Background()
+	m.missions.Add(ctx, 1, attrs)
+	m.revisions.Record(ctx, int64(revisions), attrs)
+	m.missionDurMS.Record(ctx, durationMS(dur), attrs)
+}
+
+// RecordWaveDuration records how long one wave took to execute.
+func (m *CommanderMetrics) RecordWaveDuration(waveIndex int, dur time.Duration) {
+	if m == nil {
+		return
+	}
+	m.waveDurMS.Record(
+		context.Background(),
+		durationMS(dur),
+		metric.WithAttributes(attribute.Int("wave_index", waveIndex)),
+	)
+}
+
+func durationMS(dur time.Duration) float64 {
+	if dur < 0 {
+		return 0
+	}
+	return float64(dur) / float64(time.Millisecond)
+}
@@ -0,0 +1,120 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestCommanderMetricsRecordsOutcomesAndWaveDurations(t *testing.T) {
+	meter := &recordingMeter{}
+	metrics, err := NewCommanderMetrics(meter)
+	if err != nil {
+		t.Fatalf("new commander metrics: %v", err)
+	}
+
+	metrics.RecordMissionOutcome("m1", "MISSION_COMPLETED", 2, 1500*time.Millisecond)
+	metrics.RecordWaveDuration(3, 250*time.Millisecond)
+
+	counts := meter.find("sc3.commander.missions")
+	if len(counts) != 1 || counts[0].value != 1 {
+		t.Fatalf("missions counter = %+v, want one increment", counts)
+	}
+	if got, _ := counts[0].attrs.Value("outcome"); got.AsString() != "MISSION_COMPLETED" {
+		t.Fatalf("outcome attribute = %q, want MISSION_COMPLETED", got.AsString())
+	}
+	if _, ok := counts[0].attrs.Value("mission_id"); ok {
+		t.Fatal("missions counter carries an unbounded mission_id attribute")
+	}
+	if got := meter.find("sc3.commander.mission.revisions"); len(got) != 1 || got[0].value != 2 {
+		t.Fatalf("revisions = %+v, want 2", got)
+	}
+	if got := meter.find("sc3.commander.mission.duration_ms"); len(got) != 1 || got[0].value != 1500 {
+		t.Fatalf("mission duration = %+v, want 1500ms", got)
+	}
+	waves := meter.find("sc3.commander.wave.duration_ms")
+	if len(waves) != 1 || waves[0].value != 250 {
+		t.Fatalf("wave duration = %+v, want 250ms", waves)
+	}
+	if got, _ := waves[0].attrs.Value("wave_index"); got.AsInt64() != 3 {
+		t.Fatalf("wave_index attribute = %d, want 3", got.AsInt64())
+	}
+}
+
+type recordedMeasurement struct {
+	name  string
+	value float64
+	attrs attribute.Set
+}
+
+type recordingMeter struct {
+	noop.Meter
+
+	mu           sync.Mutex
+	measurements []recordedMeasurement
+}
+
+func (m *recordingMeter) record(name string, value float64, attrs attribute.Set) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.measurements = append(m.measurements, recordedMeasurement{name: name, value: value, attrs: attrs})
+}
+
+func (m *recordingMeter) find(name string) []recordedMeasurement {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var found []recordedMeasurement
+	for _, measurement := range m.measurements {
+		if measurement.name == name {
+			found = append(found, measurement)
+		}
+	}
+	return found
+}
+
+func (m *recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &recordingInt64Counter{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64Histogram(name string, _ ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	return &recordingInt64Histogram{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return &recordingFloat64Histogram{meter: m, name: name}, nil
+}
+
+type recordingInt64Counter struct {
+	noop.Int64Counter
+	meter *recordingMeter
+	name  string
+}
+
+func (c *recordingInt64Counter) Add(_ context.Context, incr int64, options ...metric.AddOption) {
+	c.meter.record(c.name, float64(incr), metric.NewAddConfig(options).Attributes())
+}
+
+type recordingInt64Histogram struct {
+	noop.Int64Histogram
+	meter *recordingMeter
+	name  string
+}
+
+func (h *recordingInt64Histogram) Record(_ context.Context, value int64, options ...metric.RecordOption) {
+	h.meter.record(h.name, float64(value), metric.NewRecordConfig(options).Attributes())
+}
+
+type recordingFloat64Histogram struct {
+	noop.Float64Histogram
+	meter *recordingMeter
+	name  string
+}
+
+func (h *recordingFloat64Histogram) Record(_ context.Context, value float64, options ...metric.RecordOption) {
+	h.meter.record(h.name, value, metric.NewRecordConfig(options).Attributes())
+}
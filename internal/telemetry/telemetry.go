@@ -134,6 +134,10 @@ func resolveEndpoint() string {
 }
 
 func endpointFromConfig() string {
+	return valueFromConfig(endpointFromConfigPath)
+}
+
+func valueFromConfig(read func(path string) (string, error)) string {
 	homeDir, homeErr := os.UserHomeDir()
 	workDir, cwdErr := os.Getwd()
 	if homeErr != nil && cwdErr != nil {
@@ -150,7 +154,7 @@ func endpointFromConfig() string {
 
 	candidate := ""
 	for _, path := range paths {
-		value, err := endpointFromConfigPath(path)
+		value, err := read(path)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "warning: unable to read telemetry endpoint from %s: %v\n", path, err)
 			continue
@@ -164,21 +168,30 @@ func endpointFromConfig() string {
 
 type telemetryFileConfig struct {
 	OTEL struct {
-		Endpoint *string `toml:"endpoint"`
+		Endpoint     *string `toml:"endpoint"`
+		LogsEndpoint *string `toml:"logs_endpoint"`
 	} `toml:"otel"`
 	OTLPEndpoint *string `toml:"otel_endpoint"`
 }
 
-func endpointFromConfigPath(path string) (string, error) {
+func decodeTelemetryConfig(path string) (*telemetryFileConfig, error) {
 	if _, err := os.Stat(path); err != nil {
 		if os.IsNotExist(err) {
-			return "", nil
+			return nil, nil
 		}
-		return "", fmt.Errorf("stat config path: %w", err)
+		return nil, fmt.Errorf("stat config path: %w", err)
 	}
 	var decoded telemetryFileConfig
 	if _, err := toml.DecodeFile(path, &decoded); err != nil {
-		return "", fmt.Errorf("decode config file: %w", err)
+		return nil, fmt.Errorf("decode config file: %w", err)
+	}
+	return &decoded, nil
+}
+
+func endpointFromConfigPath(path string) (string, error) {
+	decoded, err := decodeTelemetryConfig(path)
+	if err != nil || decoded == nil {
+		return "", err
 	}
 	if decoded.OTEL.Endpoint != nil {
 		return strings.TrimSpace(*decoded.OTEL.Endpoint), nil
@@ -381,6 +381,8 @@ func (b *captureBus) Publish(event events.Event) {
 	b.events <- event
 }
 
+func (b *captureBus) SubscriberStats() []events.SubscriberStat { return nil }
+
 func (b *captureBus) waitForEvent(t *testing.T, timeout time.Duration) events.Event {
 	t.Helper()
 
@@ -57,6 +57,11 @@ var statusBadgeVariants = map[string]badgeVariant{
 		label: "HALTED",
 		color: theme.RedAlertColor,
 	},
+	"paused": {
+		icon:  theme.IconWaiting,
+		label: "PAUSED",
+		color: theme.YellowCautionColor,
+	},
 	"planning": {
 		icon:  theme.IconWorking,
 		label: "PLANNING",
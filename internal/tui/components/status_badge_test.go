@@ -21,6 +21,7 @@ func TestRenderStatusBadgeVariants(t *testing.T) {
 		{name: "failed", status: "failed", icon: "✗", label: "FAILED"},
 		{name: "stuck", status: "stuck", icon: "●", label: "STUCK"},
 		{name: "halted", status: "halted", icon: "✗", label: "HALTED"},
+		{name: "paused", status: "paused", icon: "⏸", label: "PAUSED"},
 		{name: "planning", status: "planning", icon: "●", label: "PLANNING"},
 		{name: "review", status: "review", icon: "●", label: "REVIEW"},
 		{name: "approved", status: "approved", icon: "✓", label: "APPROVED"},
@@ -28,6 +28,29 @@ func ClassificationBadge(classification string) (label string, color string) {
 	}
 }
 
+// ClassificationRationaleSummary renders a one-line human-readable explanation of a
+// mission's classification, including confidence and every matched criterion.
+func ClassificationRationaleSummary(mission admiral.Mission) string {
+	classification := strings.ToUpper(strings.TrimSpace(mission.Classification))
+	if classification == "" {
+		classification = "UNCLASSIFIED"
+	}
+	confidence := strings.ToLower(strings.TrimSpace(mission.ClassificationConfidence))
+	if confidence == "" {
+		confidence = "unknown"
+	}
+	criteria := strings.Join(mission.ClassificationCriteria, ", ")
+	if strings.TrimSpace(criteria) == "" {
+		criteria = "none"
+	}
+
+	summary := fmt.Sprintf("%s (%s confidence) matched criteria: %s.", classification, confidence, criteria)
+	if rationale := strings.TrimSpace(mission.ClassificationRationale); rationale != "" {
+		summary += " " + rationale
+	}
+	return summary
+}
+
 // RenderPlanReviewMission renders one mission row for Plan Review with optional expanded rationale details.
 func RenderPlanReviewMission(mission admiral.Mission, expanded bool) string {
 	badge, badgeColor := ClassificationBadge(mission.Classification)
@@ -45,6 +68,9 @@ func RenderPlanReviewMission(mission admiral.Mission, expanded bool) string {
 	if mission.ClassificationNeedsReview {
 		lines = append(lines, fmt.Sprintf("  Warning: low-confidence classification (%s)", BadgeColorWarning))
 	}
+	if review := classificationReviewLabel(mission.ClassificationReviewSource); review != "" {
+		lines = append(lines, fmt.Sprintf("  Reviewed: %s", review))
+	}
 
 	if expanded {
 		criteria := strings.Join(mission.ClassificationCriteria, ", ")
@@ -62,3 +88,17 @@ func RenderPlanReviewMission(mission admiral.Mission, expanded bool) string {
 
 	return strings.Join(lines, "\n")
 }
+
+// classificationReviewLabel describes how the Admiral resolved a low-confidence classification.
+func classificationReviewLabel(source string) string {
+	switch strings.TrimSpace(source) {
+	case "":
+		return ""
+	case "admiral_confirmed":
+		return "Admiral confirmed classification"
+	case "admiral_reclassified":
+		return "Admiral reclassified mission"
+	default:
+		return strings.TrimSpace(source)
+	}
+}
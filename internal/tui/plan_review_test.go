@@ -62,6 +62,8 @@ func TestRenderPlanReviewMissionIncludesExpandedRationaleAndWarning(t *testing.T
 		ClassificationRationale:   "Touches mission execution behavior.",
 		ClassificationConfidence:  "low",
 		ClassificationNeedsReview: true,
+		// Reviewed missions still show the low-confidence warning alongside the review outcome.
+		ClassificationReviewSource: "admiral_reclassified",
 	}
 
 	rendered := RenderPlanReviewMission(mission, true)
@@ -71,6 +73,7 @@ func TestRenderPlanReviewMissionIncludesExpandedRationaleAndWarning(t *testing.T
 		"Classification: [RED_ALERT] 🔴",
 		"Confidence: low",
 		"Warning: low-confidence classification",
+		"Reviewed: Admiral reclassified mission",
 		"Criteria: business_logic, bug_fix",
 		"Rationale: Touches mission execution behavior.",
 	} {
@@ -79,3 +82,22 @@ func TestRenderPlanReviewMissionIncludesExpandedRationaleAndWarning(t *testing.T
 		}
 	}
 }
+
+func TestClassificationRationaleSummaryIncludesCriteriaAndConfidence(t *testing.T) {
+	t.Parallel()
+
+	got := ClassificationRationaleSummary(admiral.Mission{
+		Classification:           "RED_ALERT",
+		ClassificationCriteria:   []string{"business_logic", "bug_fix"},
+		ClassificationRationale:  "Touches mission execution behavior.",
+		ClassificationConfidence: "Medium",
+	})
+	want := "RED_ALERT (medium confidence) matched criteria: business_logic, bug_fix. Touches mission execution behavior."
+	if got != want {
+		t.Fatalf("summary = %q, want %q", got, want)
+	}
+
+	if got := ClassificationRationaleSummary(admiral.Mission{}); got != "UNCLASSIFIED (unknown confidence) matched criteria: none." {
+		t.Fatalf("empty summary = %q", got)
+	}
+}
@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ship-commander/sc3/internal/commander"
+	"github.com/ship-commander/sc3/internal/tui/views"
+)
+
+const (
+	shipBridgeTimeLayout = "15:04:05"
+	commanderActor       = "commander"
+)
+
+// haltRemediations suggests the Admiral's next step for each commander halt reason.
+var haltRemediations = map[commander.HaltReason]string{
+	commander.HaltReasonMaxRevisionsExceeded:  "review the reviewer feedback and re-plan or raise the revision limit",
+	commander.HaltReasonDemoTokenInvalid:      "fix the demo token evidence and re-run the mission",
+	commander.HaltReasonDemoTokenMissing:      "have the implementer write the demo token and re-run the mission",
+	commander.HaltReasonACExhausted:           "clarify the acceptance criteria before re-dispatching",
+	commander.HaltReasonManualHalt:            "inspect the mission log and resume when ready",
+	commander.HaltReasonPreflightFailed:       "fix the failing preflight command and re-run the mission",
+	commander.HaltReasonEmptyReviewFeedback:   "ask the reviewer for actionable feedback",
+	commander.HaltReasonContextCancelled:      "resume execution to continue the mission",
+	commander.HaltReasonMissionTimeout:        "split the mission or raise the mission timeout",
+	commander.HaltReasonMaxExecutionDuration:  "resume execution or raise the execution duration cap",
+	commander.HaltReasonCriticalMissionHalted: "resolve the critical-path mission before resuming the commission",
+	commander.HaltReasonUncommittedChanges:    "commit or discard the worktree changes and re-run the mission",
+}
+
+// ToShipBridgeEvent translates a commander event into a ship bridge event log entry so the
+// TUI can render commander events directly. Halts map to error severity and carry the
+// halt reason plus a suggested next step.
+func ToShipBridgeEvent(event commander.Event) views.ShipBridgeEvent {
+	bridgeEvent := views.ShipBridgeEvent{
+		Severity: "info",
+		Actor:    commanderActor,
+	}
+	if !event.Timestamp.IsZero() {
+		bridgeEvent.Timestamp = event.Timestamp.Format(shipBridgeTimeLayout)
+	}
+	missionID := strings.TrimSpace(event.MissionID)
+	if missionID != "" {
+		bridgeEvent.Actor = missionID
+	}
+	detail := strings.TrimSpace(event.Message)
+
+	switch event.Type {
+	case commander.EventMissionHalted:
+		bridgeEvent.Severity = "error"
+		bridgeEvent.Message = haltMessage(fmt.Sprintf("Mission %s halted", missionID), event.Reason, detail)
+	case commander.EventCommissionHalted:
+		bridgeEvent.Severity = "error"
+		bridgeEvent.Message = haltMessage("Commission halted", event.Reason, detail)
+	case commander.EventMissionCompleted:
+		bridgeEvent.Message = withDetail(fmt.Sprintf("Mission %s completed", missionID), detail)
+	case commander.EventMissionWaiting:
+		bridgeEvent.Severity = "warn"
+		bridgeEvent.Message = withDetail(fmt.Sprintf("Mission %s waiting", missionID), detail)
+	case commander.EventMissionSkipped:
+		bridgeEvent.Message = withDetail(fmt.Sprintf("Mission %s skipped", missionID), detail)
+	case commander.EventMissionProgress:
+		progress := strings.TrimSpace(event.Phase)
+		if event.Percent > 0 {
+			progress = strings.TrimSpace(fmt.Sprintf("%s %d%%", progress, event.Percent))
+		}
+		bridgeEvent.Message = withDetail(fmt.Sprintf("Mission %s progress", missionID), firstNonBlank(progress, detail))
+	case commander.EventWaveFeedbackRecorded:
+		bridgeEvent.Message = withDetail(fmt.Sprintf("Wave %d feedback recorded", event.WaveIndex), detail)
+	default:
+		bridgeEvent.Message = withDetail(event.Type, detail)
+	}
+	return bridgeEvent
+}
+
+func haltMessage(subject string, reason commander.HaltReason, detail string) string {
+	if reason != "" {
+		subject = fmt.Sprintf("%s (%s)", subject, reason)
+	}
+	message := withDetail(subject, detail)
+	if remediation, ok := haltRemediations[reason]; ok {
+		message += ". Next: " + remediation
+	}
+	return message
+}
+
+func withDetail(subject, detail string) string {
+	if detail == "" {
+		return subject
+	}
+	return subject + ": " + detail
+}
+
+func firstNonBlank(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ship-commander/sc3/internal/commander"
+)
+
+func TestToShipBridgeEventMapsHaltToErrorWithRemediation(t *testing.T) {
+	t.Parallel()
+
+	event := commander.Event{
+		Type:      commander.EventMissionHalted,
+		MissionID: "m-7",
+		Timestamp: time.Date(2026, 3, 4, 9, 15, 30, 0, time.UTC),
+		Message:   "demo token not found",
+		Reason:    commander.HaltReasonDemoTokenMissing,
+	}
+
+	got := ToShipBridgeEvent(event)
+	if got.Severity != "error" {
+		t.Fatalf("severity = %q, want error", got.Severity)
+	}
+	if got.Timestamp != "09:15:30" {
+		t.Fatalf("timestamp = %q, want 09:15:30", got.Timestamp)
+	}
+	if got.Actor != "m-7" {
+		t.Fatalf("actor = %q, want m-7", got.Actor)
+	}
+	for _, want := range []string{
+		"Mission m-7 halted",
+		string(commander.HaltReasonDemoTokenMissing),
+		"demo token not found",
+		"Next: " + haltRemediations[commander.HaltReasonDemoTokenMissing],
+	} {
+		if !strings.Contains(got.Message, want) {
+			t.Fatalf("message = %q, want it to contain %q", got.Message, want)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/ship-commander/sc3/internal/locks"
 	"github.com/ship-commander/sc3/internal/tui/components"
 	"github.com/ship-commander/sc3/internal/tui/theme"
 )
@@ -87,6 +88,14 @@ type PlanReviewDependencyWave struct {
 	Missions []PlanReviewDependencyMission
 }
 
+// PlanReviewSurfaceConflict flags two same-wave missions whose surface areas overlap; the
+// commander will serialize them on surface locks, so the wave runs narrower than planned.
+type PlanReviewSurfaceConflict struct {
+	Wave       int
+	MissionIDs [2]string
+	Surfaces   [2]string
+}
+
 // PlanReviewConfig contains all render-time inputs for Plan Review.
 type PlanReviewConfig struct {
 	Width              int
@@ -101,6 +110,8 @@ type PlanReviewConfig struct {
 	ToolbarHighlighted int
 	FeedbackMode       bool
 	FeedbackText       string
+	// FocusWave limits the manifest and dependency graph to one wave; 0 shows all waves.
+	FocusWave int
 }
 
 // PlanReviewQuickAction captures direct action keys supported in this view.
@@ -175,6 +186,7 @@ func RenderPlanReview(config PlanReviewConfig) string {
 
 	layout := ResolvePlanReviewLayout(width)
 	header := renderPlanReviewHeader(config)
+	config = focusPlanReviewWave(config)
 	toolbar := components.RenderNavigableToolbar(PlanReviewToolbarButtons(), config.ToolbarHighlighted)
 
 	if layout == PlanReviewLayoutCompact {
@@ -232,12 +244,20 @@ func renderPlanReviewHeader(config PlanReviewConfig) string {
 		Foreground(theme.BlueColor).
 		Render("Directive: " + directive)
 
-	stats := strings.Join([]string{
-		fmt.Sprintf("Missions: %d", len(config.Missions)),
+	missionStat := fmt.Sprintf("Missions: %d", len(config.Missions))
+	if config.FocusWave > 0 {
+		missionStat = fmt.Sprintf("Missions: %d of %d", len(focusPlanReviewWave(config).Missions), len(config.Missions))
+	}
+	statParts := []string{
+		missionStat,
 		fmt.Sprintf("Waves: %d", countWaves(config)),
 		fmt.Sprintf("Coverage: %d%%", coveragePercent(config.Coverage)),
 		fmt.Sprintf("Sign-offs: %d/%d", clampNonNegative(config.SignoffsDone), clampNonNegative(config.SignoffsTotal)),
-	}, "   ")
+	}
+	if config.FocusWave > 0 {
+		statParts = append(statParts, fmt.Sprintf("Focus: Wave %d", config.FocusWave))
+	}
+	stats := strings.Join(statParts, "   ")
 
 	return theme.PanelBorder.Render(
 		lipgloss.JoinVertical(
@@ -249,6 +269,30 @@ func renderPlanReviewHeader(config PlanReviewConfig) string {
 	)
 }
 
+// focusPlanReviewWave filters manifest and dependency rows to config.FocusWave.
+func focusPlanReviewWave(config PlanReviewConfig) PlanReviewConfig {
+	if config.FocusWave <= 0 {
+		return config
+	}
+
+	missions := make([]PlanReviewMission, 0, len(config.Missions))
+	for _, mission := range config.Missions {
+		if mission.Wave == config.FocusWave {
+			missions = append(missions, mission)
+		}
+	}
+	dependencies := make([]PlanReviewDependencyWave, 0, 1)
+	for _, wave := range config.Dependencies {
+		if wave.Wave == config.FocusWave {
+			dependencies = append(dependencies, wave)
+		}
+	}
+
+	config.Missions = missions
+	config.Dependencies = dependencies
+	return config
+}
+
 func renderManifestPanel(missions []PlanReviewMission, width int, height int) string {
 	contentWidth := max(20, width-4)
 	contentHeight := max(4, height)
@@ -273,7 +317,7 @@ func renderStandardAnalysisPanel(config PlanReviewConfig, width int) string {
 	bottomHeight := max(5, planReviewAnalysisHeight-topHeight)
 
 	coverage := renderCoverageMatrixPanel(config.Coverage, width, topHeight)
-	dependencies := renderDependencyGraphPanel(config.Dependencies, width, bottomHeight)
+	dependencies := renderDependencyGraphPanel(config.Dependencies, DetectPlanReviewSurfaceConflicts(config.Missions), width, bottomHeight)
 	return lipgloss.JoinVertical(lipgloss.Left, coverage, dependencies)
 }
 
@@ -295,7 +339,7 @@ func renderCompactAnalysisPanel(config PlanReviewConfig, width int) string {
 	if coverageActive {
 		body = renderCoverageMatrixPanel(config.Coverage, width, 6)
 	} else {
-		body = renderDependencyGraphPanel(config.Dependencies, width, 6)
+		body = renderDependencyGraphPanel(config.Dependencies, DetectPlanReviewSurfaceConflicts(config.Missions), width, 6)
 	}
 	return lipgloss.JoinVertical(lipgloss.Left, tabLine, body)
 }
@@ -336,8 +380,8 @@ func renderCoverageMatrixPanel(rows []PlanReviewCoverageRow, width int, height i
 	return theme.PanelBorder.Render(lipgloss.JoinVertical(lipgloss.Left, title, matrix.View()))
 }
 
-func renderDependencyGraphPanel(waves []PlanReviewDependencyWave, width int, height int) string {
-	lines := renderDependencyLines(waves)
+func renderDependencyGraphPanel(waves []PlanReviewDependencyWave, conflicts []PlanReviewSurfaceConflict, width int, height int) string {
+	lines := append(renderSurfaceConflictLines(conflicts), renderDependencyLines(waves)...)
 	viewportModel := viewport.New(max(20, width-4), max(4, height))
 	viewportModel.SetContent(strings.Join(lines, "\n"))
 
@@ -345,6 +389,65 @@ func renderDependencyGraphPanel(waves []PlanReviewDependencyWave, width int, hei
 	return theme.PanelBorder.Render(lipgloss.JoinVertical(lipgloss.Left, title, viewportModel.View()))
 }
 
+// DetectPlanReviewSurfaceConflicts returns each pair of same-wave missions whose surface
+// areas overlap, using the same pattern rules as surface locks. SurfaceArea may list several
+// comma-separated patterns.
+func DetectPlanReviewSurfaceConflicts(missions []PlanReviewMission) []PlanReviewSurfaceConflict {
+	conflicts := make([]PlanReviewSurfaceConflict, 0)
+	for i := 0; i < len(missions); i++ {
+		for j := i + 1; j < len(missions); j++ {
+			first, second := missions[i], missions[j]
+			if first.Wave != second.Wave {
+				continue
+			}
+			firstSurface, secondSurface, ok := overlappingSurfaces(first.SurfaceArea, second.SurfaceArea)
+			if !ok {
+				continue
+			}
+			conflicts = append(conflicts, PlanReviewSurfaceConflict{
+				Wave:       first.Wave,
+				MissionIDs: [2]string{strings.TrimSpace(first.ID), strings.TrimSpace(second.ID)},
+				Surfaces:   [2]string{firstSurface, secondSurface},
+			})
+		}
+	}
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].Wave < conflicts[j].Wave
+	})
+	return conflicts
+}
+
+func overlappingSurfaces(a string, b string) (string, string, bool) {
+	for _, left := range normalizeNonEmpty(strings.Split(a, ",")) {
+		for _, right := range normalizeNonEmpty(strings.Split(b, ",")) {
+			if locks.PatternsOverlap(left, right) {
+				return left, right, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func renderSurfaceConflictLines(conflicts []PlanReviewSurfaceConflict) []string {
+	lines := make([]string, 0, len(conflicts))
+	style := lipgloss.NewStyle().Foreground(theme.YellowCautionColor).Bold(true)
+	for _, conflict := range conflicts {
+		surface := conflict.Surfaces[0]
+		if conflict.Surfaces[1] != surface {
+			surface += " / " + conflict.Surfaces[1]
+		}
+		lines = append(lines, style.Render(fmt.Sprintf(
+			"%s Wave %d surface conflict: %s and %s share %s (will serialize)",
+			theme.IconAlert,
+			conflict.Wave,
+			conflict.MissionIDs[0],
+			conflict.MissionIDs[1],
+			surface,
+		)))
+	}
+	return lines
+}
+
 func renderDependencyLines(waves []PlanReviewDependencyWave) []string {
 	if len(waves) == 0 {
 		return []string{"No dependencies mapped."}
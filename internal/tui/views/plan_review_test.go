@@ -64,6 +64,57 @@ func TestRenderPlanReviewFeedbackModeShowsInlineInput(t *testing.T) {
 	}
 }
 
+func TestRenderPlanReviewFocusWaveShowsOnlyFocusedMissions(t *testing.T) {
+	t.Parallel()
+
+	config := samplePlanReviewConfig(128)
+	config.FocusWave = 2
+	rendered := RenderPlanReview(config)
+
+	for _, expected := range []string{
+		"Missions: 1 of 3",
+		"Focus: Wave 2",
+		"M-003",
+		"Wave 2",
+	} {
+		if !strings.Contains(rendered, expected) {
+			t.Fatalf("focused plan review missing %q\n%s", expected, rendered)
+		}
+	}
+	for _, unexpected := range []string{"M-001 Initialize", "M-002 Implement", "Wave 1"} {
+		if strings.Contains(rendered, unexpected) {
+			t.Fatalf("focused plan review should not render %q\n%s", unexpected, rendered)
+		}
+	}
+}
+
+func TestRenderPlanReviewWarnsOnSameWaveSurfaceConflicts(t *testing.T) {
+	t.Parallel()
+
+	if rendered := RenderPlanReview(samplePlanReviewConfig(128)); strings.Contains(rendered, "surface conflict") {
+		t.Fatalf("disjoint surfaces should not render a conflict\n%s", rendered)
+	}
+
+	config := samplePlanReviewConfig(128)
+	config.Missions[1].SurfaceArea = "docs, internal/tui/**"
+	config.Missions[2].SurfaceArea = "internal/tui/views"
+
+	conflicts := DetectPlanReviewSurfaceConflicts(config.Missions)
+	want := PlanReviewSurfaceConflict{
+		Wave:       1,
+		MissionIDs: [2]string{"M-001", "M-002"},
+		Surfaces:   [2]string{"internal/tui/views", "internal/tui/**"},
+	}
+	if len(conflicts) != 1 || conflicts[0] != want {
+		t.Fatalf("conflicts = %+v, want only %+v", conflicts, want)
+	}
+
+	rendered := RenderPlanReview(config)
+	if !strings.Contains(rendered, "Wave 1 surface conflict: M-001 and M-002") {
+		t.Fatalf("plan review missing surface conflict warning\n%s", rendered)
+	}
+}
+
 func TestResolvePlanReviewLayout(t *testing.T) {
 	t.Parallel()
 
@@ -41,6 +41,8 @@ const (
 	ShipBridgeStatusComplete ShipBridgeStatus = "complete"
 	// ShipBridgeStatusHalted indicates execution is paused due to failure/halt.
 	ShipBridgeStatusHalted ShipBridgeStatus = "halted"
+	// ShipBridgeStatusPaused indicates the operator paused execution; it can be resumed.
+	ShipBridgeStatusPaused ShipBridgeStatus = "paused"
 )
 
 // ShipBridgeCrewMember captures one crew row in the bridge panel.
@@ -59,11 +61,14 @@ type ShipBridgeMission struct {
 	Title          string
 	Column         string
 	Classification string
-	AssignedAgent  string
-	Phase          string
-	ACCompleted    int
-	ACTotal        int
-	Stuck          bool
+	// ClassificationConfidence is the classifier's confidence (low/medium/high);
+	// low-confidence missions render a faint marker next to the classification badge.
+	ClassificationConfidence string
+	AssignedAgent            string
+	Phase                    string
+	ACCompleted              int
+	ACTotal                  int
+	Stuck                    bool
 }
 
 // ShipBridgeEvent captures one event log line.
@@ -93,6 +98,9 @@ type ShipBridgeConfig struct {
 	SelectedMissionIndex int
 	Events               []ShipBridgeEvent
 	ToolbarHighlighted   int
+	// ClassificationFilter limits the mission board to one classification (for example RED_ALERT).
+	// Empty shows every mission.
+	ClassificationFilter string
 }
 
 // ShipBridgeQuickAction captures direct keyboard actions supported in this view.
@@ -117,6 +125,8 @@ const (
 	ShipBridgeQuickActionHalt ShipBridgeQuickAction = "halt"
 	// ShipBridgeQuickActionRetry retries halted work.
 	ShipBridgeQuickActionRetry ShipBridgeQuickAction = "retry"
+	// ShipBridgeQuickActionResume resumes a paused ship.
+	ShipBridgeQuickActionResume ShipBridgeQuickAction = "resume"
 	// ShipBridgeQuickActionDock docks a launched ship.
 	ShipBridgeQuickActionDock ShipBridgeQuickAction = "dock"
 )
@@ -198,6 +208,14 @@ func ShipBridgeToolbarButtons(status ShipBridgeStatus) []components.ToolbarButto
 			{Key: "Esc", Label: "Fleet", Enabled: true},
 		}
 	}
+	if normalized == ShipBridgeStatusPaused {
+		return []components.ToolbarButton{
+			{Key: "r", Label: "Resume", Enabled: true},
+			{Key: "d", Label: "Dock", Enabled: true},
+			{Key: "?", Label: "Help", Enabled: true},
+			{Key: "Esc", Label: "Fleet", Enabled: true},
+		}
+	}
 
 	return []components.ToolbarButton{
 		{Key: "h", Label: "Halt", Enabled: true},
@@ -221,7 +239,7 @@ func ShipBridgeEnterTargetForPanel(panelID string) string {
 	}
 }
 
-// ShipBridgeQuickActionForKey resolves direct action keys for docked/launched states.
+// ShipBridgeQuickActionForKey resolves direct action keys for docked/paused/launched states.
 func ShipBridgeQuickActionForKey(msg tea.KeyMsg, status ShipBridgeStatus) ShipBridgeQuickAction {
 	key := strings.ToLower(strings.TrimSpace(msg.String()))
 	normalized := normalizeShipBridgeStatus(status)
@@ -247,6 +265,16 @@ func ShipBridgeQuickActionForKey(msg tea.KeyMsg, status ShipBridgeStatus) ShipBr
 			return ShipBridgeQuickActionNone
 		}
 	}
+	if normalized == ShipBridgeStatusPaused {
+		switch key {
+		case "r":
+			return ShipBridgeQuickActionResume
+		case "d":
+			return ShipBridgeQuickActionDock
+		default:
+			return ShipBridgeQuickActionNone
+		}
+	}
 
 	switch key {
 	case "h":
@@ -270,15 +298,16 @@ func RenderShipBridge(config ShipBridgeConfig) string {
 	layout := ResolveShipBridgeLayout(width)
 	status := normalizeShipBridgeStatus(config.Status)
 
+	missionBoard := filterShipBridgeMissions(config.Missions, config.ClassificationFilter)
 	selectedCrew := normalizeSelectedIndex(config.SelectedCrewIndex, len(config.Crew))
-	selectedMission := normalizeSelectedIndex(config.SelectedMissionIndex, len(config.Missions))
+	selectedMission := normalizeSelectedIndex(config.SelectedMissionIndex, len(missionBoard.Missions))
 
 	header := renderShipBridgeHeader(config, status)
 	toolbar := components.RenderNavigableToolbar(ShipBridgeToolbarButtons(status), config.ToolbarHighlighted)
 
 	if layout == ShipBridgeLayoutCompact {
 		crewPanel := renderCrewPanel(config.Crew, selectedCrew, width)
-		missionPanel := renderMissionBoardPanel(config.Missions, selectedMission, status, width)
+		missionPanel := renderMissionBoardPanel(missionBoard, selectedMission, status, width)
 		eventPanel := renderEventLogPanel(config.Events, width, 4)
 		return lipgloss.JoinVertical(lipgloss.Left, header, crewPanel, missionPanel, eventPanel, toolbar)
 	}
@@ -293,7 +322,7 @@ func RenderShipBridge(config ShipBridgeConfig) string {
 	}
 
 	crewPanel := lipgloss.NewStyle().Width(leftWidth).Render(renderCrewPanel(config.Crew, selectedCrew, leftWidth))
-	missionPanel := lipgloss.NewStyle().Width(rightWidth).Render(renderMissionBoardPanel(config.Missions, selectedMission, status, rightWidth))
+	missionPanel := lipgloss.NewStyle().Width(rightWidth).Render(renderMissionBoardPanel(missionBoard, selectedMission, status, rightWidth))
 	panelRow := lipgloss.JoinHorizontal(lipgloss.Top, crewPanel, lipgloss.NewStyle().Width(shipBridgePanelGap).Render(""), missionPanel)
 	eventPanel := renderEventLogPanel(config.Events, width, 5)
 
@@ -451,8 +480,48 @@ func renderCrewRoleBadge(role string) string {
 	return style.Render(label)
 }
 
-func renderMissionBoardPanel(missions []ShipBridgeMission, selected int, status ShipBridgeStatus, width int) string {
+// shipBridgeMissionBoard is the mission board content after applying the classification filter.
+type shipBridgeMissionBoard struct {
+	Missions []ShipBridgeMission
+	Filter   string
+	Total    int
+}
+
+// filterShipBridgeMissions keeps missions matching filter; unclassified missions count as STANDARD_OPS,
+// matching how mission cards render them.
+func filterShipBridgeMissions(missions []ShipBridgeMission, filter string) shipBridgeMissionBoard {
+	filter = strings.ToUpper(strings.TrimSpace(filter))
+	board := shipBridgeMissionBoard{Missions: missions, Filter: filter, Total: len(missions)}
+	if filter == "" {
+		return board
+	}
+
+	filtered := make([]ShipBridgeMission, 0, len(missions))
+	for _, mission := range missions {
+		classification := strings.ToUpper(strings.TrimSpace(mission.Classification))
+		if classification == "" {
+			classification = "STANDARD_OPS"
+		}
+		if classification == filter {
+			filtered = append(filtered, mission)
+		}
+	}
+	board.Missions = filtered
+	return board
+}
+
+func renderMissionBoardPanel(board shipBridgeMissionBoard, selected int, status ShipBridgeStatus, width int) string {
+	missions := board.Missions
 	summary := renderMissionSummary(missions)
+	if board.Filter != "" {
+		summary = lipgloss.JoinVertical(
+			lipgloss.Left,
+			summary,
+			lipgloss.NewStyle().Foreground(theme.GalaxyGrayColor).Render(
+				fmt.Sprintf("Filter: %s (%d of %d missions)", board.Filter, len(missions), board.Total),
+			),
+		)
+	}
 
 	if len(missions) == 0 {
 		empty := lipgloss.NewStyle().Foreground(theme.GalaxyGrayColor).Faint(true).Render(missionBoardEmptyMessage(status))
@@ -548,6 +617,11 @@ func renderMissionCard(mission ShipBridgeMission, selected bool, width int) stri
 		progress = "AC 0/0"
 	}
 
+	confidence := ""
+	if strings.EqualFold(strings.TrimSpace(mission.ClassificationConfidence), "low") {
+		confidence = " " + lipgloss.NewStyle().Foreground(theme.GalaxyGrayColor).Faint(true).Render("low")
+	}
+
 	stuck := ""
 	if mission.Stuck {
 		stuck = "  " + lipgloss.NewStyle().Foreground(theme.YellowCautionColor).Bold(true).Render(theme.IconAlert+" STUCK")
@@ -560,6 +634,7 @@ func renderMissionCard(mission ShipBridgeMission, selected bool, width int) stri
 			lipgloss.NewStyle().Foreground(theme.ButterscotchColor).Bold(true).Render(id),
 			"  ",
 			classificationStyle.Render(classification),
+			confidence,
 			stuck,
 		),
 		lipgloss.NewStyle().Foreground(theme.SpaceWhiteColor).Render(title),
@@ -675,6 +750,8 @@ func mapShipStatusToBadge(status ShipBridgeStatus) string {
 		return "done"
 	case ShipBridgeStatusHalted:
 		return "halted"
+	case ShipBridgeStatusPaused:
+		return "paused"
 	default:
 		return "running"
 	}
@@ -703,6 +780,8 @@ func normalizeShipBridgeStatus(status ShipBridgeStatus) ShipBridgeStatus {
 		return ShipBridgeStatusComplete
 	case "halted", "failed":
 		return ShipBridgeStatusHalted
+	case "paused", "pause":
+		return ShipBridgeStatusPaused
 	default:
 		return ShipBridgeStatusLaunched
 	}
@@ -714,6 +793,8 @@ func renderHealthDots(status ShipBridgeStatus) string {
 	switch normalized {
 	case ShipBridgeStatusHalted:
 		filled = 2
+	case ShipBridgeStatusPaused:
+		filled = 3
 	case ShipBridgeStatusDocked:
 		filled = 4
 	case ShipBridgeStatusComplete:
@@ -65,6 +65,51 @@ func TestRenderShipBridgeIncludesHeaderCrewMissionEventAndLaunchedToolbar(t *tes
 	}
 }
 
+func TestRenderShipBridgeClassificationFilterShowsOnlyMatchingMissions(t *testing.T) {
+	t.Parallel()
+
+	config := ShipBridgeConfig{
+		Width:                128,
+		ShipName:             "USS Enterprise",
+		Status:               ShipBridgeStatusLaunched,
+		ClassificationFilter: "red_alert",
+		Missions: []ShipBridgeMission{
+			{ID: "M-001", Title: "Backlog item", Column: "backlog", Classification: "STANDARD_OPS"},
+			{ID: "M-003", Title: "Auth middleware", Column: "in_progress", Classification: "RED_ALERT"},
+			{ID: "M-004", Title: "Review docs", Column: "review"},
+			{ID: "M-009", Title: "Token rotation", Column: "review", Classification: "RED_ALERT"},
+		},
+	}
+
+	rendered := RenderShipBridge(config)
+	for _, expected := range []string{"M-003", "M-009", "B:0", "IP:1", "R:1", "Filter: RED_ALERT (2 of 4 missions)"} {
+		if !strings.Contains(rendered, expected) {
+			t.Fatalf("filtered ship bridge missing %q\n%s", expected, rendered)
+		}
+	}
+	for _, unexpected := range []string{"M-001", "M-004"} {
+		if strings.Contains(rendered, unexpected) {
+			t.Fatalf("filtered ship bridge should not render %q\n%s", unexpected, rendered)
+		}
+	}
+}
+
+func TestRenderMissionCardShowsLowClassificationConfidence(t *testing.T) {
+	t.Parallel()
+
+	low := renderMissionCard(ShipBridgeMission{ID: "M-001", Title: "Auth middleware", Classification: "RED_ALERT", ClassificationConfidence: "Low"}, false, 60)
+	if !strings.Contains(low, "RED_ALERT low") {
+		t.Fatalf("low-confidence card missing indicator\n%s", low)
+	}
+
+	for _, confidence := range []string{"high", ""} {
+		card := renderMissionCard(ShipBridgeMission{ID: "M-002", Title: "Auth middleware", Classification: "RED_ALERT", ClassificationConfidence: confidence}, false, 60)
+		if strings.Contains(card, "low") {
+			t.Fatalf("confidence %q card should not render low indicator\n%s", confidence, card)
+		}
+	}
+}
+
 func TestRenderShipBridgeDockedToolbarAndEmptyMissionState(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +181,9 @@ func TestShipBridgeQuickActionForKey(t *testing.T) {
 		{key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}, status: ShipBridgeStatusLaunched, want: ShipBridgeQuickActionHelp},
 		{key: tea.KeyMsg{Type: tea.KeyEsc}, status: ShipBridgeStatusLaunched, want: ShipBridgeQuickActionFleet},
 		{key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}, status: ShipBridgeStatusLaunched, want: ShipBridgeQuickActionNone},
+		{key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}, status: ShipBridgeStatusPaused, want: ShipBridgeQuickActionResume},
+		{key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}}, status: ShipBridgeStatusPaused, want: ShipBridgeQuickActionDock},
+		{key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}}, status: ShipBridgeStatusPaused, want: ShipBridgeQuickActionNone},
 	}
 
 	for _, tt := range tests {
@@ -145,6 +193,32 @@ func TestShipBridgeQuickActionForKey(t *testing.T) {
 	}
 }
 
+func TestShipBridgePausedStatusBadgeAndToolbar(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []ShipBridgeStatus{"paused", " PAUSE "} {
+		if got := normalizeShipBridgeStatus(raw); got != ShipBridgeStatusPaused {
+			t.Fatalf("normalize(%q) = %q, want %q", raw, got, ShipBridgeStatusPaused)
+		}
+	}
+	if got := mapShipStatusToBadge(ShipBridgeStatusPaused); got != "paused" {
+		t.Fatalf("paused badge = %q, want paused", got)
+	}
+
+	labels := make([]string, 0, 4)
+	for _, button := range ShipBridgeToolbarButtons(ShipBridgeStatusPaused) {
+		labels = append(labels, button.Label)
+	}
+	if want := []string{"Resume", "Dock", "Help", "Fleet"}; strings.Join(labels, ",") != strings.Join(want, ",") {
+		t.Fatalf("paused toolbar = %v, want %v", labels, want)
+	}
+
+	rendered := RenderShipBridge(ShipBridgeConfig{Width: 128, ShipName: "USS Enterprise", Status: ShipBridgeStatusPaused})
+	if !strings.Contains(rendered, "PAUSED") {
+		t.Fatalf("paused ship bridge missing PAUSED badge\n%s", rendered)
+	}
+}
+
 func TestRenderShipBridgeEventLogClampsToLast50(t *testing.T) {
 	t.Parallel()
 